@@ -1,17 +1,25 @@
 package quando
 
-import "time"
+import (
+	"fmt"
+	"math"
+	"time"
+)
 
 // Add adds the specified number of units to the date and returns a new Date.
 // The original date is not modified (immutability).
 //
-// Supported units: Seconds, Minutes, Hours, Days, Weeks, Months, Quarters, Years
+// Supported units: Nanoseconds, Microseconds, Milliseconds, Seconds, Minutes,
+// Hours, Days, Weeks, Months, Quarters, HalfYears, Years, Decades, Centuries.
+// Unknown units leave the date unchanged.
 //
 // Month-End Overflow Behavior:
 //
-// When adding months (or quarters/years which add months internally), if the target
+// When adding months (or larger units, which add months internally), if the target
 // day doesn't exist in the destination month, the date is snapped to the last day
-// of that month instead of overflowing into the next month.
+// of that month instead of overflowing into the next month. Use
+// WithMonthEndPolicy to roll over into the next month or to keep month-end
+// dates on the last day of the month instead.
 //
 // Examples:
 //   - 2026-01-31 + 1 month  = 2026-02-28 (February has only 28 days in 2026)
@@ -23,6 +31,9 @@ import "time"
 //
 // Adding Days means "same time on the next calendar day", not "24 hours later".
 // This ensures that operations work intuitively across DST transitions.
+// Units shorter than a day (Hours, Minutes, ...) are elapsed time instead.
+// Use AddWallClock or AddAbsolute to apply one of the two semantics to all
+// units.
 //
 // Example:
 //   - 2026-03-31 02:00 CET + 1 Day = 2026-04-01 02:00 CEST (not 03:00)
@@ -40,6 +51,15 @@ func (d Date) Add(value int, unit Unit) Date {
 	t := d.t
 
 	switch unit {
+	case Nanoseconds:
+		t = t.Add(time.Duration(value))
+
+	case Microseconds:
+		t = t.Add(time.Duration(value) * time.Microsecond)
+
+	case Milliseconds:
+		t = t.Add(time.Duration(value) * time.Millisecond)
+
 	case Seconds:
 		t = t.Add(time.Duration(value) * time.Second)
 
@@ -59,18 +79,30 @@ func (d Date) Add(value int, unit Unit) Date {
 
 	case Months:
 		// Add months with month-end overflow handling
-		t = addMonthsWithOverflow(t, value)
+		t = addMonths(t, value, d.monthEnd)
 
 	case Quarters:
 		// 1 quarter = 3 months
-		t = addMonthsWithOverflow(t, value*3)
+		t = addMonths(t, value*3, d.monthEnd)
+
+	case HalfYears:
+		// 1 half-year = 6 months
+		t = addMonths(t, value*6, d.monthEnd)
 
 	case Years:
 		// 1 year = 12 months
-		t = addMonthsWithOverflow(t, value*12)
+		t = addMonths(t, value*12, d.monthEnd)
+
+	case Decades:
+		// 1 decade = 120 months
+		t = addMonths(t, value*120, d.monthEnd)
+
+	case Centuries:
+		// 1 century = 1200 months
+		t = addMonths(t, value*1200, d.monthEnd)
 	}
 
-	return Date{t: t, lang: d.lang}
+	return d.with(t)
 }
 
 // Sub subtracts the specified number of units from the date and returns a new Date.
@@ -84,6 +116,222 @@ func (d Date) Sub(value int, unit Unit) Date {
 	return d.Add(-value, unit)
 }
 
+// AddWallClock adds the specified number of units to the wall clock time of
+// the date, as if the clock face were moved forward, and returns a new Date.
+// All units are applied to the local date and time, ignoring DST transitions:
+// 24 Hours is the same as 1 Day (same time on the next calendar day), even if
+// only 23 or 25 hours elapse.
+//
+// If the resulting wall clock time does not exist because clocks spring
+// forward, it is moved forward by the length of the gap (02:30 becomes 03:30
+// in Europe/Berlin). If it occurs twice because clocks fall back, the
+// occurrence with the same UTC offset as the date is returned, so that
+// positive values never move the date backwards; if neither occurrence has
+// that offset, the first one is returned.
+//
+// Month-end handling follows the date's month-end policy, as with Add.
+//
+// Example:
+//
+//	// Night shift starting the day before clocks spring forward in Berlin
+//	start := quando.From(time.Date(2026, 3, 28, 22, 0, 0, 0, berlin))
+//	end := start.AddWallClock(8, quando.Hours) // 2026-03-29 06:00 CEST (7 hours elapsed)
+func (d Date) AddWallClock(value int, unit Unit) Date {
+	wall := wallClock(d.t)
+	result := d.with(wall).Add(value, unit).t
+	if result.Equal(wall) {
+		// Keep the original instant for repeated wall clock times
+		return d
+	}
+	_, offset := d.t.Zone()
+	return d.with(fromWallClock(result, d.t.Location(), offset))
+}
+
+// SubWallClock subtracts the specified number of units from the wall clock
+// time of the date. This is equivalent to AddWallClock with a negative value.
+//
+// Example:
+//
+//	date := quando.From(time.Date(2026, 3, 29, 12, 0, 0, 0, berlin))
+//	result := date.SubWallClock(24, quando.Hours) // 2026-03-28 12:00 CET (23 hours earlier)
+func (d Date) SubWallClock(value int, unit Unit) Date {
+	return d.AddWallClock(-value, unit)
+}
+
+// AddAbsolute adds the specified number of units as elapsed time and returns
+// a new Date. Units of a fixed length are applied on the UTC timeline, where
+// every day has exactly 24 hours: 1 Day is always 24 hours and 1 Week always
+// 168 hours later, even across DST transitions, so the local time of day may
+// change by the DST offset. Units shorter than a day behave as with Add.
+//
+// Months and longer units have no fixed length, so they cannot be applied as
+// elapsed time. They are applied to the local calendar date, exactly as with
+// Add (including the date's month-end policy).
+//
+// Example:
+//
+//	// 2026-03-29 has only 23 hours in Berlin
+//	date := quando.From(time.Date(2026, 3, 28, 12, 0, 0, 0, berlin))
+//	result := date.AddAbsolute(1, quando.Days) // 2026-03-29 13:00 CEST (24 hours elapsed)
+func (d Date) AddAbsolute(value int, unit Unit) Date {
+	switch unit {
+	case Days:
+		return d.with(d.t.Add(time.Duration(value) * 24 * time.Hour))
+	case Weeks:
+		return d.with(d.t.Add(time.Duration(value) * 7 * 24 * time.Hour))
+	default:
+		return d.Add(value, unit)
+	}
+}
+
+// SubAbsolute subtracts the specified number of units as elapsed time. This is
+// equivalent to AddAbsolute with a negative value.
+//
+// Example:
+//
+//	date := quando.From(time.Date(2026, 3, 29, 13, 0, 0, 0, berlin))
+//	result := date.SubAbsolute(1, quando.Days) // 2026-03-28 12:00 CET (24 hours earlier)
+func (d Date) SubAbsolute(value int, unit Unit) Date {
+	return d.AddAbsolute(-value, unit)
+}
+
+// fromWallClock returns the instant at which the wall clock in loc shows the
+// time wall (represented in UTC, see wallClock). Times skipped by a forward
+// transition are moved forward by the length of the gap; times repeated by a
+// backward transition resolve to the occurrence with the given UTC offset (in
+// seconds), or to the first occurrence if neither has it.
+func fromWallClock(wall time.Time, loc *time.Location, offset int) time.Time {
+	// The offsets in effect a day before and after cover any single transition
+	_, before := wall.Add(-24 * time.Hour).In(loc).Zone()
+	_, after := wall.Add(24 * time.Hour).In(loc).Zone()
+
+	first := wall.Add(-time.Duration(before) * time.Second).In(loc)
+	second := wall.Add(-time.Duration(after) * time.Second).In(loc)
+	firstOK := wallClock(first).Equal(wall)
+	secondOK := wallClock(second).Equal(wall)
+
+	switch {
+	case firstOK && secondOK:
+		if _, o := second.Zone(); o == offset {
+			return second
+		}
+		if _, o := first.Zone(); o != offset && second.Before(first) {
+			return second
+		}
+		return first
+	case secondOK:
+		return second
+	default:
+		// Valid with the earlier offset, or in a gap: the earlier offset moves
+		// the wall clock forward by the length of the gap
+		return first
+	}
+}
+
+// minYear and maxYear bound the dates produced by TryAdd and TrySub.
+const (
+	minYear = 1
+	maxYear = 9999
+)
+
+// TryAdd is like Add, but reports an error instead of returning a
+// meaningless date when the operation overflows.
+//
+// Returns an error wrapping ErrOverflow if:
+//   - value is so large that converting it to the unit would overflow (e.g.
+//     value*12 months for Years, or value*time.Hour for Hours)
+//   - the result lies outside the years 1 to 9999
+//
+// Unknown units leave the date unchanged, as with Add.
+//
+// Example:
+//
+//	date := quando.MustParse("9999-06-01")
+//	_, err := date.TryAdd(1, quando.Years)
+//	errors.Is(err, quando.ErrOverflow) // true
+func (d Date) TryAdd(value int, unit Unit) (Date, error) {
+	result, err := d.checkedAdd(value, unit)
+	if err != nil {
+		return Date{}, fmt.Errorf("adding %d %s to %s: %w", value, unit, d, err)
+	}
+	return result, nil
+}
+
+// TrySub is like Sub, but reports an error wrapping ErrOverflow instead of
+// returning a meaningless date when the operation overflows. See TryAdd.
+//
+// Example:
+//
+//	date := quando.MustParse("0001-01-01")
+//	_, err := date.TrySub(1, quando.Days)
+//	errors.Is(err, quando.ErrOverflow) // true
+func (d Date) TrySub(value int, unit Unit) (Date, error) {
+	if value == math.MinInt {
+		return Date{}, fmt.Errorf("subtracting %d %s from %s: value cannot be negated: %w", value, unit, d, ErrOverflow)
+	}
+	result, err := d.checkedAdd(-value, unit)
+	if err != nil {
+		return Date{}, fmt.Errorf("subtracting %d %s from %s: %w", value, unit, d, err)
+	}
+	return result, nil
+}
+
+// checkedAdd adds value units to the date after checking that neither the
+// unit conversion nor the result leaves the supported range.
+func (d Date) checkedAdd(value int, unit Unit) (Date, error) {
+	if limit := maxAddValue(unit); int64(value) > limit || int64(value) < -limit {
+		return Date{}, fmt.Errorf("value exceeds the supported maximum of %d %s: %w", limit, unit, ErrOverflow)
+	}
+
+	result := d.Add(value, unit)
+	if year := result.t.Year(); year < minYear || year > maxYear {
+		return Date{}, fmt.Errorf("result year %d outside %d-%d: %w", year, minYear, maxYear, ErrOverflow)
+	}
+	return result, nil
+}
+
+// maxAddValue returns the largest magnitude of value that Add can process
+// for the unit. For units of a fixed duration, larger values overflow
+// time.Duration; for calendar units, they would leave the year range no
+// matter the start date.
+func maxAddValue(unit Unit) int64 {
+	const days = (maxYear - minYear + 1) * 366
+	const months = (maxYear - minYear + 1) * 12
+
+	switch unit {
+	case Nanoseconds:
+		return math.MaxInt64
+	case Microseconds:
+		return math.MaxInt64 / int64(time.Microsecond)
+	case Milliseconds:
+		return math.MaxInt64 / int64(time.Millisecond)
+	case Seconds:
+		return math.MaxInt64 / int64(time.Second)
+	case Minutes:
+		return math.MaxInt64 / int64(time.Minute)
+	case Hours:
+		return math.MaxInt64 / int64(time.Hour)
+	case Days:
+		return days
+	case Weeks:
+		return days / 7
+	case Months:
+		return months
+	case Quarters:
+		return months / 3
+	case HalfYears:
+		return months / 6
+	case Years:
+		return months / 12
+	case Decades:
+		return months / 120
+	case Centuries:
+		return months / 1200
+	default:
+		return math.MaxInt64
+	}
+}
+
 // addMonthsWithOverflow adds months to a time.Time with month-end overflow handling.
 // If the target day doesn't exist in the destination month, it snaps to the last day.
 func addMonthsWithOverflow(t time.Time, months int) time.Time {
@@ -92,12 +340,10 @@ func addMonthsWithOverflow(t time.Time, months int) time.Time {
 	month := int(t.Month()) + months
 	day := t.Day()
 
-	// Handle year overflow/underflow
-	for month > 12 {
-		year++
-		month -= 12
-	}
-	for month < 1 {
+	// Handle year overflow/underflow (floor division, so large values do not loop)
+	year += (month - 1) / 12
+	month = (month-1)%12 + 1
+	if month < 1 {
 		year--
 		month += 12
 	}
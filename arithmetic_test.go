@@ -1,6 +1,8 @@
 package quando
 
 import (
+	"errors"
+	"math"
 	"testing"
 	"time"
 )
@@ -468,3 +470,292 @@ func TestAdd_DSTMultipleTimezones(t *testing.T) {
 	}
 }
 
+func TestAddExtendedUnits(t *testing.T) {
+	base := From(time.Date(2026, 1, 31, 12, 0, 0, 500, time.UTC))
+
+	tests := []struct {
+		name  string
+		value int
+		unit  Unit
+		want  time.Time
+	}{
+		{"nanoseconds", 250, Nanoseconds, time.Date(2026, 1, 31, 12, 0, 0, 750, time.UTC)},
+		{"microseconds", 3, Microseconds, time.Date(2026, 1, 31, 12, 0, 0, 3500, time.UTC)},
+		{"milliseconds", -2, Milliseconds, time.Date(2026, 1, 31, 11, 59, 59, 998000500, time.UTC)},
+		{"half-year clamps month end", 1, HalfYears, time.Date(2026, 7, 31, 12, 0, 0, 500, time.UTC)},
+		{"half-year back", -1, HalfYears, time.Date(2025, 7, 31, 12, 0, 0, 500, time.UTC)},
+		{"decade", 1, Decades, time.Date(2036, 1, 31, 12, 0, 0, 500, time.UTC)},
+		{"century", -1, Centuries, time.Date(1926, 1, 31, 12, 0, 0, 500, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := base.Add(tt.value, tt.unit).Time(); !got.Equal(tt.want) {
+				t.Errorf("Add(%d, %v) = %v, want %v", tt.value, tt.unit, got, tt.want)
+			}
+			if got := base.Sub(-tt.value, tt.unit).Time(); !got.Equal(tt.want) {
+				t.Errorf("Sub(%d, %v) = %v, want %v", -tt.value, tt.unit, got, tt.want)
+			}
+		})
+	}
+
+	// Month-end clamping applies to the large units as well
+	leap := From(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC))
+	if got := leap.Add(1, Decades).String(); got != "2034-02-28 00:00:00" {
+		t.Errorf("Add(1, Decades) from leap day = %v, want 2034-02-28 00:00:00", got)
+	}
+	if got := leap.Add(1, HalfYears).String(); got != "2024-08-29 00:00:00" {
+		t.Errorf("Add(1, HalfYears) = %v, want 2024-08-29 00:00:00", got)
+	}
+}
+
+func TestTryAdd(t *testing.T) {
+	base := From(time.Date(2026, 1, 31, 12, 0, 0, 0, time.UTC))
+
+	units := []Unit{
+		Nanoseconds, Microseconds, Milliseconds, Seconds, Minutes, Hours,
+		Days, Weeks, Months, Quarters, HalfYears, Years, Decades, Centuries,
+	}
+	for _, unit := range units {
+		t.Run(unit.String(), func(t *testing.T) {
+			for _, value := range []int{0, 1, -1, 7} {
+				got, err := base.TryAdd(value, unit)
+				if err != nil {
+					t.Fatalf("TryAdd(%d, %v) error = %v", value, unit, err)
+				}
+				if want := base.Add(value, unit); !got.Equal(want) {
+					t.Errorf("TryAdd(%d, %v) = %v, want %v", value, unit, got, want)
+				}
+			}
+
+			for _, value := range []int{math.MaxInt, math.MinInt, math.MaxInt / 2} {
+				if unit == Nanoseconds && value != math.MinInt {
+					continue // fits into time.Duration (about 292 years)
+				}
+				if _, err := base.TryAdd(value, unit); !errors.Is(err, ErrOverflow) {
+					t.Errorf("TryAdd(%d, %v) error = %v, want ErrOverflow", value, unit, err)
+				}
+			}
+		})
+	}
+}
+
+func TestTryAdd_ResultRange(t *testing.T) {
+	late := From(time.Date(9999, 6, 1, 0, 0, 0, 0, time.UTC))
+	early := From(time.Date(1, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name    string
+		date    Date
+		value   int
+		unit    Unit
+		wantErr bool
+	}{
+		{"last year stays in range", late, 6, Months, false},
+		{"past year 9999", late, 1, Years, true},
+		{"past year 9999 by days", late, 214, Days, true},
+		{"past year 9999 by hours", late, 24 * 214, Hours, true},
+		{"first year stays in range", early, -5, Months, false},
+		{"before year 1", early, -1, Years, true},
+		{"before year 1 by weeks", early, -30, Weeks, true},
+		{"whole range forward", early, 9998, Years, false},
+		{"unknown unit is a no-op", late, math.MaxInt, Unit(99), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.date.TryAdd(tt.value, tt.unit)
+			if tt.wantErr {
+				if !errors.Is(err, ErrOverflow) {
+					t.Fatalf("TryAdd(%d, %v) error = %v, want ErrOverflow", tt.value, tt.unit, err)
+				}
+				if !got.IsZero() {
+					t.Errorf("TryAdd(%d, %v) = %v, want zero Date on error", tt.value, tt.unit, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TryAdd(%d, %v) error = %v", tt.value, tt.unit, err)
+			}
+			if want := tt.date.Add(tt.value, tt.unit); !got.Equal(want) {
+				t.Errorf("TryAdd(%d, %v) = %v, want %v", tt.value, tt.unit, got, want)
+			}
+		})
+	}
+}
+
+func TestTrySub(t *testing.T) {
+	date := From(time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	if _, err := date.TrySub(1, Days); !errors.Is(err, ErrOverflow) {
+		t.Errorf("TrySub(1, Days) error = %v, want ErrOverflow", err)
+	}
+	if _, err := date.TrySub(math.MinInt, Nanoseconds); !errors.Is(err, ErrOverflow) {
+		t.Errorf("TrySub(MinInt, Nanoseconds) error = %v, want ErrOverflow", err)
+	}
+
+	got, err := date.TrySub(-1, Months)
+	if err != nil {
+		t.Fatalf("TrySub(-1, Months) error = %v", err)
+	}
+	if want := "0001-02-01 00:00:00"; got.String() != want {
+		t.Errorf("TrySub(-1, Months) = %v, want %v", got, want)
+	}
+}
+
+func TestAdd_LargeMonthValues(t *testing.T) {
+	// Month arithmetic must not iterate once per year
+	date := From(time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC))
+	if got := date.Add(12*1000, Months).String(); got != "3026-01-31 00:00:00" {
+		t.Errorf("Add(12000, Months) = %v, want 3026-01-31 00:00:00", got)
+	}
+	if got := date.Add(-12*1000-1, Months).String(); got != "1025-12-31 00:00:00" {
+		t.Errorf("Add(-12001, Months) = %v, want 1025-12-31 00:00:00", got)
+	}
+}
+
+func TestAddWallClockAndAbsolute_DST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data not available: %v", err)
+	}
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("timezone data not available: %v", err)
+	}
+
+	utc := func(year int, month time.Month, day, hour, min int) time.Time {
+		return time.Date(year, month, day, hour, min, 0, 0, time.UTC)
+	}
+
+	// Instants are given in UTC, so that repeated wall clock times are unambiguous.
+	// Berlin: 2026-03-29 02:00 CET -> 03:00 CEST, 2026-10-25 03:00 CEST -> 02:00 CET.
+	// New York: 2026-03-08 02:00 EST -> 03:00 EDT, 2026-11-01 02:00 EDT -> 01:00 EST.
+	tests := []struct {
+		name  string
+		loc   *time.Location
+		start time.Time
+		op    func(Date, int, Unit) Date
+		value int
+		unit  Unit
+		want  time.Time
+	}{
+		// Europe/Berlin, spring forward
+		{"berlin wall 24 hours", berlin, utc(2026, 3, 28, 11, 0), Date.AddWallClock, 24, Hours, utc(2026, 3, 29, 10, 0)},
+		{"berlin wall 8 hours night shift", berlin, utc(2026, 3, 28, 21, 0), Date.AddWallClock, 8, Hours, utc(2026, 3, 29, 4, 0)},
+		{"berlin wall into gap", berlin, utc(2026, 3, 29, 0, 30), Date.AddWallClock, 1, Hours, utc(2026, 3, 29, 1, 30)},
+		{"berlin wall 1440 minutes", berlin, utc(2026, 3, 28, 11, 0), Date.AddWallClock, 1440, Minutes, utc(2026, 3, 29, 10, 0)},
+		{"berlin wall sub 24 hours", berlin, utc(2026, 3, 29, 10, 0), Date.SubWallClock, 24, Hours, utc(2026, 3, 28, 11, 0)},
+		{"berlin absolute day", berlin, utc(2026, 3, 28, 11, 0), Date.AddAbsolute, 1, Days, utc(2026, 3, 29, 11, 0)},
+		{"berlin absolute month is calendar based", berlin, utc(2026, 3, 15, 11, 0), Date.AddAbsolute, 1, Months, utc(2026, 4, 15, 10, 0)},
+		{"berlin absolute month near midnight", berlin, utc(2026, 2, 28, 23, 30), Date.AddAbsolute, 1, Months, utc(2026, 3, 31, 22, 30)},
+		{"berlin absolute hours", berlin, utc(2026, 3, 28, 11, 0), Date.AddAbsolute, 24, Hours, utc(2026, 3, 29, 11, 0)},
+		{"berlin absolute sub day", berlin, utc(2026, 3, 29, 11, 0), Date.SubAbsolute, 1, Days, utc(2026, 3, 28, 11, 0)},
+
+		// Europe/Berlin, fall back
+		{"berlin wall day", berlin, utc(2026, 10, 24, 10, 0), Date.AddWallClock, 1, Days, utc(2026, 10, 25, 11, 0)},
+		{"berlin wall into repeated hour", berlin, utc(2026, 10, 24, 23, 30), Date.AddWallClock, 1, Hours, utc(2026, 10, 25, 0, 30)},
+		{"berlin wall from repeated hour", berlin, utc(2026, 10, 25, 1, 30), Date.AddWallClock, -1, Hours, utc(2026, 10, 24, 23, 30)},
+		{"berlin wall zero keeps instant", berlin, utc(2026, 10, 25, 1, 30), Date.AddWallClock, 0, Hours, utc(2026, 10, 25, 1, 30)},
+		{"berlin wall within second occurrence", berlin, utc(2026, 10, 25, 1, 30), Date.AddWallClock, 10, Minutes, utc(2026, 10, 25, 1, 40)},
+		{"berlin wall within first occurrence", berlin, utc(2026, 10, 25, 0, 30), Date.AddWallClock, 10, Minutes, utc(2026, 10, 25, 0, 40)},
+		{"berlin wall back into second occurrence", berlin, utc(2026, 10, 25, 2, 30), Date.AddWallClock, -1, Hours, utc(2026, 10, 25, 1, 30)},
+		{"berlin wall 24 hours over 25", berlin, utc(2026, 10, 24, 10, 0), Date.AddWallClock, 24, Hours, utc(2026, 10, 25, 11, 0)},
+		{"berlin absolute day", berlin, utc(2026, 10, 24, 10, 0), Date.AddAbsolute, 1, Days, utc(2026, 10, 25, 10, 0)},
+		{"berlin absolute week", berlin, utc(2026, 10, 21, 10, 0), Date.AddAbsolute, 1, Weeks, utc(2026, 10, 28, 10, 0)},
+
+		// America/New_York, spring forward
+		{"new york wall 24 hours", newYork, utc(2026, 3, 7, 14, 0), Date.AddWallClock, 24, Hours, utc(2026, 3, 8, 13, 0)},
+		{"new york wall into gap", newYork, utc(2026, 3, 8, 6, 30), Date.AddWallClock, 1, Hours, utc(2026, 3, 8, 7, 30)},
+		{"new york absolute week", newYork, utc(2026, 3, 5, 14, 0), Date.AddAbsolute, 1, Weeks, utc(2026, 3, 12, 14, 0)},
+		{"new york absolute sub hours", newYork, utc(2026, 3, 8, 13, 0), Date.SubAbsolute, 24, Hours, utc(2026, 3, 7, 13, 0)},
+
+		// America/New_York, fall back
+		{"new york absolute day", newYork, utc(2026, 10, 31, 13, 0), Date.AddAbsolute, 1, Days, utc(2026, 11, 1, 13, 0)},
+		{"new york wall day", newYork, utc(2026, 10, 31, 13, 0), Date.AddWallClock, 1, Days, utc(2026, 11, 1, 14, 0)},
+		{"new york wall into repeated hour", newYork, utc(2026, 11, 1, 4, 30), Date.AddWallClock, 1, Hours, utc(2026, 11, 1, 5, 30)},
+		{"new york wall within second occurrence", newYork, utc(2026, 11, 1, 6, 30), Date.AddWallClock, 10, Minutes, utc(2026, 11, 1, 6, 40)},
+		{"new york sub wall within second occurrence", newYork, utc(2026, 11, 1, 6, 40), Date.SubWallClock, 10, Minutes, utc(2026, 11, 1, 6, 30)},
+		{"new york wall 12 hours", newYork, utc(2026, 11, 1, 4, 0), Date.AddWallClock, 12, Hours, utc(2026, 11, 1, 17, 0)},
+		{"new york sub wall minutes", newYork, utc(2026, 11, 1, 7, 0), Date.SubWallClock, 90, Minutes, utc(2026, 11, 1, 4, 30)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date := From(tt.start.In(tt.loc))
+			got := tt.op(date, tt.value, tt.unit).Time()
+			if !got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want.In(tt.loc))
+			}
+			if got.Location() != tt.loc {
+				t.Errorf("location = %v, want %v", got.Location(), tt.loc)
+			}
+		})
+	}
+}
+
+func TestAddWallClockAndAbsolute_MatchAdd(t *testing.T) {
+	// Without DST transitions, all three semantics agree. The dates are close
+	// to local midnight, so that their calendar day differs from the one in UTC.
+	dates := []Date{
+		From(time.Date(2026, 1, 31, 1, 30, 0, 0, time.FixedZone("UTC+2", 2*3600))),
+		From(time.Date(2026, 1, 31, 23, 30, 0, 0, time.FixedZone("UTC-5", -5*3600))),
+		From(time.Date(2026, 2, 28, 0, 15, 0, 0, time.FixedZone("UTC+14", 14*3600))),
+		From(time.Date(2026, 5, 31, 23, 45, 0, 0, time.FixedZone("UTC-11", -11*3600))),
+	}
+	units := []Unit{
+		Nanoseconds, Microseconds, Milliseconds, Seconds, Minutes, Hours,
+		Days, Weeks, Months, Quarters, HalfYears, Years, Decades, Centuries,
+	}
+
+	for _, date := range dates {
+		for _, unit := range units {
+			for _, value := range []int{-3, 0, 1, 25} {
+				want := date.Add(value, unit)
+				if got := date.AddWallClock(value, unit); !got.Equal(want) {
+					t.Errorf("%v AddWallClock(%d, %v) = %v, want %v", date, value, unit, got, want)
+				}
+				if got := date.AddAbsolute(value, unit); !got.Equal(want) {
+					t.Errorf("%v AddAbsolute(%d, %v) = %v, want %v", date, value, unit, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestAddWallClock_KeepsDirection(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data not available: %v", err)
+	}
+
+	// Walk minute by minute through the night clocks fall back, from either occurrence
+	start := time.Date(2026, 10, 24, 23, 0, 0, 0, time.UTC)
+	for i := 0; i <= 240; i += 5 {
+		date := From(start.Add(time.Duration(i) * time.Minute).In(berlin))
+		for _, value := range []int{1, 10, 30, 59, 60, 90} {
+			if got := date.AddWallClock(value, Minutes); !got.After(date) {
+				t.Errorf("%v AddWallClock(%d, Minutes) = %v, want a later instant", date.Time(), value, got.Time())
+			}
+			if got := date.SubWallClock(value, Minutes); !got.Before(date) {
+				t.Errorf("%v SubWallClock(%d, Minutes) = %v, want an earlier instant", date.Time(), value, got.Time())
+			}
+		}
+	}
+}
+
+func TestAddWallClockAndAbsolute_Settings(t *testing.T) {
+	date := MustParse("2026-02-28").WithLang(DE).WithMonthEndPolicy(MonthEndSticky)
+
+	for name, got := range map[string]Date{
+		"AddWallClock": date.AddWallClock(1, Months),
+		"AddAbsolute":  date.AddAbsolute(1, Months),
+	} {
+		if got.String() != "2026-03-31 00:00:00" {
+			t.Errorf("%s(1, Months) = %v, want 2026-03-31 00:00:00", name, got)
+		}
+		if got.lang != DE || got.MonthEndPolicy() != MonthEndSticky {
+			t.Errorf("%s did not keep the date's settings", name)
+		}
+	}
+}
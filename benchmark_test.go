@@ -160,6 +160,44 @@ func BenchmarkDurationDays(b *testing.B) {
 	}
 }
 
+// BenchmarkDurationDaysLongSpan benchmarks Days() across eight centuries.
+// Days() is constant-time, so this should be as fast as BenchmarkDurationDays.
+func BenchmarkDurationDaysLongSpan(b *testing.B) {
+	start := time.Date(1600, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2400, 12, 31, 0, 0, 0, 0, time.UTC)
+	dur := Diff(start, end)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = dur.Days()
+	}
+}
+
+// BenchmarkDurationDaysLongSpanDST benchmarks Days() across centuries in a DST timezone
+func BenchmarkDurationDaysLongSpanDST(b *testing.B) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		b.Skipf("timezone data unavailable: %v", err)
+	}
+	start := time.Date(1900, 1, 1, 12, 0, 0, 0, loc)
+	end := time.Date(2300, 6, 30, 6, 0, 0, 0, loc)
+	dur := Diff(start, end)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = dur.Days()
+	}
+}
+
+// BenchmarkDurationWeeksLongSpan benchmarks Weeks() across eight centuries
+func BenchmarkDurationWeeksLongSpan(b *testing.B) {
+	start := time.Date(1600, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2400, 12, 31, 0, 0, 0, 0, time.UTC)
+	dur := Diff(start, end)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = dur.Weeks()
+	}
+}
+
 // BenchmarkDurationMonths benchmarks Months()
 func BenchmarkDurationMonths(b *testing.B) {
 	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
@@ -194,6 +232,18 @@ func BenchmarkDurationHuman(b *testing.B) {
 	}
 }
 
+// BenchmarkDurationHumanLongSpan benchmarks Human() across eight centuries
+func BenchmarkDurationHumanLongSpan(b *testing.B) {
+	start := time.Date(1600, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2400, 11, 17, 5, 30, 45, 0, time.UTC)
+	dur := Diff(start, end)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = dur.Human(EN)
+	}
+}
+
 // BenchmarkDurationHumanGerman benchmarks Human() with German
 func BenchmarkDurationHumanGerman(b *testing.B) {
 	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
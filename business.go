@@ -0,0 +1,250 @@
+package quando
+
+import "time"
+
+// HolidayCalendar decides whether a date is a holiday (a non-working day in
+// addition to weekends). Implement this interface to plug custom holiday
+// rules into the business-day methods.
+//
+// Implementations should only look at the calendar date (year, month, day)
+// of d in d's location and ignore the time of day.
+//
+// Example:
+//
+//	type companyHolidays struct{}
+//
+//	func (companyHolidays) IsHoliday(d quando.Date) bool {
+//	    t := d.Time()
+//	    return t.Month() == time.December && t.Day() == 24
+//	}
+type HolidayCalendar interface {
+	// IsHoliday reports whether d is a holiday in this calendar.
+	IsHoliday(d Date) bool
+}
+
+// HolidayFunc is an adapter to allow the use of ordinary functions as
+// holiday calendars.
+//
+// Example:
+//
+//	christmasEve := quando.HolidayFunc(func(d quando.Date) bool {
+//	    t := d.Time()
+//	    return t.Month() == time.December && t.Day() == 24
+//	})
+type HolidayFunc func(d Date) bool
+
+// IsHoliday calls f(d).
+func (f HolidayFunc) IsHoliday(d Date) bool {
+	return f(d)
+}
+
+// Holiday is a named holiday as returned by the built-in holiday calendars.
+type Holiday struct {
+	Date Date   // Day of the holiday, at 00:00 UTC
+	Name string // Name of the holiday in the calendar's language
+}
+
+// civilDate identifies a calendar day independent of time of day and location.
+type civilDate struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+// civilDateOf returns the calendar day of t in t's location.
+func civilDateOf(t time.Time) civilDate {
+	year, month, day := t.Date()
+	return civilDate{year: year, month: month, day: day}
+}
+
+// holidayList is a HolidayCalendar backed by a fixed set of calendar days.
+type holidayList map[civilDate]struct{}
+
+// NewHolidayList returns a HolidayCalendar that treats the calendar days of
+// the given dates as holidays. Only the year, month and day of each date are
+// used; the time of day is ignored.
+//
+// Example:
+//
+//	companyHolidays := quando.NewHolidayList(
+//	    quando.MustParse("2026-12-24"),
+//	    quando.MustParse("2026-12-31"),
+//	)
+func NewHolidayList(dates ...Date) HolidayCalendar {
+	list := make(holidayList, len(dates))
+	for _, d := range dates {
+		list[civilDateOf(d.t)] = struct{}{}
+	}
+	return list
+}
+
+// IsHoliday reports whether the calendar day of d is in the list.
+func (l holidayList) IsHoliday(d Date) bool {
+	_, ok := l[civilDateOf(d.t)]
+	return ok
+}
+
+// maxNonBusinessDays bounds the search for the next business day, so that
+// calendars without any business days cannot cause an endless loop.
+const maxNonBusinessDays = 3660
+
+// IsHoliday reports whether the date is a holiday in any of the given calendars.
+// Returns false if no calendars are given.
+//
+// Example:
+//
+//	date := quando.MustParse("2026-12-24")
+//	date.IsHoliday(companyHolidays) // true
+func (d Date) IsHoliday(calendars ...HolidayCalendar) bool {
+	for _, cal := range calendars {
+		if cal != nil && cal.IsHoliday(d) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBusinessDay reports whether the date is a business day: neither a weekend
+// day (see IsWeekend and WithWeekend) nor a holiday in any of the given
+// calendars.
+//
+// Without calendars, only weekends are considered.
+//
+// Example:
+//
+//	date := quando.MustParse("2026-02-09") // Monday
+//	date.IsBusinessDay() // true
+func (d Date) IsBusinessDay(calendars ...HolidayCalendar) bool {
+	return !d.IsWeekend() && !d.IsHoliday(calendars...)
+}
+
+// AddBusinessDays adds the specified number of business days to the date and
+// returns a new Date. Weekends (see WithWeekend) and holidays of the given
+// calendars are skipped.
+// The time of day is preserved, and days are calendar days (DST-safe), as with
+// Add(n, Days).
+//
+// The start date itself is never counted, even if it is a business day.
+// Negative values move backwards, and zero returns the date unchanged.
+//
+// If the calendars report no business day within ten years, the zero Date is
+// returned instead of searching forever.
+//
+// Example:
+//
+//	// Invoice issued Friday, Feb 13, 2026, payable net 10 business days
+//	issued := quando.MustParse("2026-02-13")
+//	due := issued.AddBusinessDays(10) // Friday, Feb 27, 2026
+func (d Date) AddBusinessDays(n int, calendars ...HolidayCalendar) Date {
+	step := 1
+	if n < 0 {
+		step = -1
+		n = -n
+	}
+
+	current := d
+	for ; n > 0; n-- {
+		next, ok := current.stepToBusinessDay(step, calendars)
+		if !ok {
+			return Date{}
+		}
+		current = next
+	}
+	return current
+}
+
+// SubBusinessDays subtracts the specified number of business days from the
+// date and returns a new Date. This is equivalent to AddBusinessDays with a
+// negative value.
+//
+// Example:
+//
+//	date := quando.MustParse("2026-02-16")  // Monday
+//	result := date.SubBusinessDays(1)       // Friday, Feb 13, 2026
+func (d Date) SubBusinessDays(n int, calendars ...HolidayCalendar) Date {
+	return d.AddBusinessDays(-n, calendars...)
+}
+
+// NextBusinessDay returns the next business day after the date.
+// Like Next, it never returns the date itself. The time of day is preserved.
+//
+// Example:
+//
+//	friday := quando.MustParse("2026-02-13")
+//	monday := friday.NextBusinessDay() // Feb 16, 2026
+func (d Date) NextBusinessDay(calendars ...HolidayCalendar) Date {
+	return d.AddBusinessDays(1, calendars...)
+}
+
+// PrevBusinessDay returns the business day before the date.
+// Like Prev, it never returns the date itself. The time of day is preserved.
+//
+// Example:
+//
+//	monday := quando.MustParse("2026-02-16")
+//	friday := monday.PrevBusinessDay() // Feb 13, 2026
+func (d Date) PrevBusinessDay(calendars ...HolidayCalendar) Date {
+	return d.AddBusinessDays(-1, calendars...)
+}
+
+// stepToBusinessDay moves one calendar day at a time in the given direction
+// until a business day is reached. Reports false if none is found within
+// maxNonBusinessDays.
+func (d Date) stepToBusinessDay(step int, calendars []HolidayCalendar) (Date, bool) {
+	for i := 1; i <= maxNonBusinessDays; i++ {
+		candidate := d.with(d.t.AddDate(0, 0, step*i))
+		if candidate.IsBusinessDay(calendars...) {
+			return candidate, true
+		}
+	}
+	return Date{}, false
+}
+
+// BusinessDays returns the number of business days in the duration, using
+// weekends and the holidays of the given calendars. Weekends are Saturday and
+// Sunday unless configured otherwise with WithWeekend.
+//
+// Calendar days are counted in the location of the start time. The business
+// days after the earlier day up to and including the later day are counted:
+//   - positive durations count the days in (start, end]
+//   - negative durations count the days in (end, start] and return the
+//     negated count
+//
+// The count is therefore antisymmetric, Diff(a, b) == -Diff(b, a) for dates
+// in the same location, and
+//
+//	quando.Diff(a.Time(), a.AddBusinessDays(n).Time()).BusinessDays() == n
+//
+// holds for any business day a and any n, including negative n.
+//
+// Example:
+//
+//	start := time.Date(2026, 2, 13, 0, 0, 0, 0, time.UTC) // Friday
+//	end := time.Date(2026, 2, 20, 0, 0, 0, 0, time.UTC)   // next Friday
+//	quando.Diff(start, end).BusinessDays() // 5
+func (d Duration) BusinessDays(calendars ...HolidayCalendar) int {
+	start := d.start
+	end := d.end.In(start.Location())
+
+	// Iterate calendar days at noon to stay clear of DST transitions
+	first := time.Date(start.Year(), start.Month(), start.Day(), 12, 0, 0, 0, start.Location())
+	last := time.Date(end.Year(), end.Month(), end.Day(), 12, 0, 0, 0, start.Location())
+
+	days := int(daysFromCivil(last.Year(), last.Month(), last.Day()) -
+		daysFromCivil(first.Year(), first.Month(), first.Day()))
+
+	// Positive: (start, end]; negative: (end, start]
+	lo, hi, sign := 1, days, 1
+	if days < 0 {
+		lo, hi, sign = days+1, 0, -1
+	}
+
+	count := 0
+	for i := lo; i <= hi; i++ {
+		day := Date{t: first.AddDate(0, 0, i), lang: EN, weekend: d.weekend}
+		if day.IsBusinessDay(calendars...) {
+			count++
+		}
+	}
+	return sign * count
+}
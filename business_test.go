@@ -0,0 +1,264 @@
+package quando
+
+import (
+	"testing"
+	"time"
+)
+
+// testHolidays marks Feb 16 and Feb 17, 2026 (Monday and Tuesday) as holidays
+var testHolidays = NewHolidayList(
+	MustParse("2026-02-16"),
+	MustParse("2026-02-17"),
+)
+
+func TestHolidayFunc(t *testing.T) {
+	christmasEve := HolidayFunc(func(d Date) bool {
+		return d.Time().Month() == time.December && d.Time().Day() == 24
+	})
+
+	if !christmasEve.IsHoliday(MustParse("2026-12-24")) {
+		t.Error("HolidayFunc.IsHoliday(Dec 24) = false, want true")
+	}
+	if christmasEve.IsHoliday(MustParse("2026-12-25")) {
+		t.Error("HolidayFunc.IsHoliday(Dec 25) = true, want false")
+	}
+}
+
+func TestNewHolidayList(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     Date
+		expected bool
+	}{
+		{"listed day at midnight", From(time.Date(2026, 2, 16, 0, 0, 0, 0, time.UTC)), true},
+		{"listed day in the evening", From(time.Date(2026, 2, 17, 23, 59, 0, 0, time.UTC)), true},
+		{"unlisted day", From(time.Date(2026, 2, 18, 0, 0, 0, 0, time.UTC)), false},
+		{"same day, different year", From(time.Date(2025, 2, 16, 0, 0, 0, 0, time.UTC)), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := testHolidays.IsHoliday(tt.date); got != tt.expected {
+				t.Errorf("IsHoliday(%v) = %v, want %v", tt.date, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDateIsHoliday(t *testing.T) {
+	date := MustParse("2026-02-16")
+	other := NewHolidayList(MustParse("2026-12-24"))
+
+	if date.IsHoliday() {
+		t.Error("IsHoliday() without calendars = true, want false")
+	}
+	if !date.IsHoliday(testHolidays) {
+		t.Error("IsHoliday(testHolidays) = false, want true")
+	}
+	if !date.IsHoliday(other, testHolidays) {
+		t.Error("IsHoliday(other, testHolidays) = false, want true")
+	}
+	if date.IsHoliday(other) {
+		t.Error("IsHoliday(other) = true, want false")
+	}
+	if date.IsHoliday(nil) {
+		t.Error("IsHoliday(nil) = true, want false")
+	}
+}
+
+func TestIsBusinessDay(t *testing.T) {
+	tests := []struct {
+		name      string
+		date      string
+		calendars []HolidayCalendar
+		expected  bool
+	}{
+		{"Friday", "2026-02-13", nil, true},
+		{"Saturday", "2026-02-14", nil, false},
+		{"Sunday", "2026-02-15", nil, false},
+		{"Monday without calendar", "2026-02-16", nil, true},
+		{"Monday holiday", "2026-02-16", []HolidayCalendar{testHolidays}, false},
+		{"Wednesday after holidays", "2026-02-18", []HolidayCalendar{testHolidays}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MustParse(tt.date).IsBusinessDay(tt.calendars...); got != tt.expected {
+				t.Errorf("IsBusinessDay() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	tests := []struct {
+		name      string
+		date      string
+		n         int
+		calendars []HolidayCalendar
+		expected  string
+	}{
+		{"zero", "2026-02-14", 0, nil, "2026-02-14"},
+		{"Monday + 1", "2026-02-09", 1, nil, "2026-02-10"},
+		{"Friday + 1 skips weekend", "2026-02-13", 1, nil, "2026-02-16"},
+		{"Saturday + 1", "2026-02-14", 1, nil, "2026-02-16"},
+		{"Friday + 5", "2026-02-13", 5, nil, "2026-02-20"},
+		{"net 10 business days", "2026-02-13", 10, nil, "2026-02-27"},
+		{"Friday + 1 with holidays", "2026-02-13", 1, []HolidayCalendar{testHolidays}, "2026-02-18"},
+		{"Friday + 5 with holidays", "2026-02-13", 5, []HolidayCalendar{testHolidays}, "2026-02-24"},
+		{"Monday - 1", "2026-02-16", -1, nil, "2026-02-13"},
+		{"Sunday - 1", "2026-02-15", -1, nil, "2026-02-13"},
+		{"Wednesday - 1 with holidays", "2026-02-18", -1, []HolidayCalendar{testHolidays}, "2026-02-13"},
+		{"across month end", "2026-01-30", 1, nil, "2026-02-02"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := MustParse(tt.date).AddBusinessDays(tt.n, tt.calendars...)
+			if result.Format(ISO) != tt.expected {
+				t.Errorf("AddBusinessDays(%d) = %v, want %v", tt.n, result.Format(ISO), tt.expected)
+			}
+		})
+	}
+}
+
+func TestAddBusinessDaysPreservesTimeAndLang(t *testing.T) {
+	date := From(time.Date(2026, 2, 13, 15, 30, 0, 0, time.UTC)).WithLang(DE)
+	result := date.AddBusinessDays(1)
+
+	if result.String() != "2026-02-16 15:30:00" {
+		t.Errorf("AddBusinessDays(1) = %v, want 2026-02-16 15:30:00", result)
+	}
+	if result.lang != DE {
+		t.Errorf("AddBusinessDays(1) lang = %v, want %v", result.lang, DE)
+	}
+}
+
+func TestAddBusinessDaysDST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	// Friday before the spring-forward weekend (2026-03-29)
+	date := From(time.Date(2026, 3, 27, 9, 0, 0, 0, berlin))
+	result := date.AddBusinessDays(1)
+
+	expected := time.Date(2026, 3, 30, 9, 0, 0, 0, berlin)
+	if !result.Time().Equal(expected) {
+		t.Errorf("AddBusinessDays(1) = %v, want %v", result.Time(), expected)
+	}
+}
+
+func TestAddBusinessDaysNoBusinessDays(t *testing.T) {
+	always := HolidayFunc(func(Date) bool { return true })
+	result := MustParse("2026-02-09").AddBusinessDays(1, always)
+
+	if result != (Date{}) {
+		t.Errorf("AddBusinessDays() with no business days = %v, want zero Date", result)
+	}
+}
+
+func TestSubBusinessDays(t *testing.T) {
+	result := MustParse("2026-02-20").SubBusinessDays(5)
+	if result.Format(ISO) != "2026-02-13" {
+		t.Errorf("SubBusinessDays(5) = %v, want 2026-02-13", result.Format(ISO))
+	}
+
+	result = MustParse("2026-02-13").SubBusinessDays(-1)
+	if result.Format(ISO) != "2026-02-16" {
+		t.Errorf("SubBusinessDays(-1) = %v, want 2026-02-16", result.Format(ISO))
+	}
+}
+
+func TestNextPrevBusinessDay(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     string
+		next     string
+		prev     string
+		calendar HolidayCalendar
+	}{
+		{"Wednesday", "2026-02-11", "2026-02-12", "2026-02-10", nil},
+		{"Friday", "2026-02-13", "2026-02-16", "2026-02-12", nil},
+		{"Saturday", "2026-02-14", "2026-02-16", "2026-02-13", nil},
+		{"Friday with holidays", "2026-02-13", "2026-02-18", "2026-02-12", testHolidays},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date := MustParse(tt.date)
+			if got := date.NextBusinessDay(tt.calendar); got.Format(ISO) != tt.next {
+				t.Errorf("NextBusinessDay() = %v, want %v", got.Format(ISO), tt.next)
+			}
+			if got := date.PrevBusinessDay(tt.calendar); got.Format(ISO) != tt.prev {
+				t.Errorf("PrevBusinessDay() = %v, want %v", got.Format(ISO), tt.prev)
+			}
+		})
+	}
+}
+
+func TestDurationBusinessDays(t *testing.T) {
+	tests := []struct {
+		name      string
+		start     string
+		end       string
+		calendars []HolidayCalendar
+		expected  int
+	}{
+		{"same day", "2026-02-13", "2026-02-13", nil, 0},
+		{"Friday to Monday", "2026-02-13", "2026-02-16", nil, 1},
+		{"Friday to Friday", "2026-02-13", "2026-02-20", nil, 5},
+		{"Saturday to Sunday", "2026-02-14", "2026-02-15", nil, 0},
+		{"full month", "2026-01-31", "2026-02-28", nil, 20},
+		{"with holidays", "2026-02-13", "2026-02-20", []HolidayCalendar{testHolidays}, 3},
+		{"negative", "2026-02-20", "2026-02-13", nil, -5},
+		{"negative with holidays", "2026-02-20", "2026-02-13", []HolidayCalendar{testHolidays}, -3},
+		{"Saturday to Friday", "2026-02-14", "2026-02-13", nil, 0},
+		{"Monday to Friday", "2026-02-16", "2026-02-13", nil, -1},
+		{"Sunday to Friday", "2026-02-22", "2026-02-20", nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dur := Diff(MustParse(tt.start).Time(), MustParse(tt.end).Time())
+			if got := dur.BusinessDays(tt.calendars...); got != tt.expected {
+				t.Errorf("BusinessDays() = %d, want %d", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestBusinessDaysInverse verifies that BusinessDays inverts AddBusinessDays
+func TestBusinessDaysInverse(t *testing.T) {
+	start := MustParse("2026-02-09")
+	for n := -30; n <= 30; n++ {
+		for offset := 0; offset < 7; offset++ {
+			a := start.Add(offset, Days)
+			if !a.IsBusinessDay(testHolidays) {
+				continue
+			}
+			b := a.AddBusinessDays(n, testHolidays)
+			if got := Diff(a.Time(), b.Time()).BusinessDays(testHolidays); got != n {
+				t.Errorf("BusinessDays(%v -> %v) = %d, want %d", a.Format(ISO), b.Format(ISO), got, n)
+			}
+		}
+	}
+}
+
+// TestBusinessDaysAntisymmetric verifies that swapping start and end negates the count
+func TestBusinessDaysAntisymmetric(t *testing.T) {
+	start := MustParse("2026-02-09")
+	for i := 0; i < 21; i++ {
+		for j := 0; j < 21; j++ {
+			a := start.Add(i, Days).Time()
+			b := start.Add(j, Days).Time()
+			forward := Diff(a, b).BusinessDays(testHolidays)
+			backward := Diff(b, a).BusinessDays(testHolidays)
+			if forward != -backward {
+				t.Errorf("BusinessDays(%v -> %v) = %d, but reversed = %d",
+					a.Format("2006-01-02"), b.Format("2006-01-02"), forward, backward)
+			}
+		}
+	}
+}
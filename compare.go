@@ -0,0 +1,110 @@
+package quando
+
+// Before reports whether the date is before other.
+// Only the instant in time is compared; location and language are ignored.
+//
+// Example:
+//
+//	a := quando.MustParse("2026-02-09")
+//	b := quando.MustParse("2026-02-10")
+//	a.Before(b) // true
+func (d Date) Before(other Date) bool {
+	return d.t.Before(other.t)
+}
+
+// After reports whether the date is after other.
+// Only the instant in time is compared; location and language are ignored.
+//
+// Example:
+//
+//	a := quando.MustParse("2026-02-10")
+//	b := quando.MustParse("2026-02-09")
+//	a.After(b) // true
+func (d Date) After(other Date) bool {
+	return d.t.After(other.t)
+}
+
+// Equal reports whether the date and other represent the same instant in time.
+// Two dates in different timezones can be equal, and the language setting is
+// ignored. Use Equal rather than == to compare dates.
+//
+// Example:
+//
+//	utc := quando.From(time.Date(2026, 2, 9, 12, 0, 0, 0, time.UTC))
+//	berlin, _ := utc.In("Europe/Berlin")
+//	utc.Equal(berlin) // true
+func (d Date) Equal(other Date) bool {
+	return d.t.Equal(other.t)
+}
+
+// Compare compares the date with other. It returns -1 if the date is before
+// other, +1 if it is after other, and 0 if both represent the same instant.
+//
+// Compare has the signature expected by slices.SortFunc and related functions:
+//
+//	slices.SortFunc(dates, quando.Date.Compare)
+func (d Date) Compare(other Date) int {
+	return d.t.Compare(other.t)
+}
+
+// IsSame reports whether the date and other fall into the same unit, for
+// example the same day, week, month, quarter or year.
+//
+// The comparison follows StartOf semantics: both dates are snapped to the start
+// of the unit and the results are compared. other is first converted to the
+// date's timezone, so "same day" means the same calendar day in the date's
+// location. Weeks start on the date's week start (Monday by default, see
+// WithWeekStart).
+//
+// Returns false for unknown Unit values.
+//
+// Example:
+//
+//	a := quando.From(time.Date(2026, 2, 9, 8, 0, 0, 0, time.UTC))  // Monday
+//	b := quando.From(time.Date(2026, 2, 15, 20, 0, 0, 0, time.UTC)) // Sunday
+//	a.IsSame(b, quando.Weeks)  // true
+//	a.IsSame(b, quando.Days)   // false
+//	a.IsSame(b, quando.Months) // true
+func (d Date) IsSame(other Date, unit Unit) bool {
+	o := d.with(other.t.In(d.t.Location()))
+
+	switch unit {
+	case Nanoseconds, Microseconds, Milliseconds, Seconds, Minutes, Hours, Days,
+		Weeks, Months, Quarters, HalfYears, Years, Decades, Centuries:
+		return d.StartOf(unit).Equal(o.StartOf(unit))
+	default:
+		return false
+	}
+}
+
+// Min returns the earliest of the given dates.
+// If several dates represent the same earliest instant, the first one is returned.
+//
+// Example:
+//
+//	earliest := quando.Min(a, b, c)
+func Min(first Date, rest ...Date) Date {
+	result := first
+	for _, d := range rest {
+		if d.Before(result) {
+			result = d
+		}
+	}
+	return result
+}
+
+// Max returns the latest of the given dates.
+// If several dates represent the same latest instant, the first one is returned.
+//
+// Example:
+//
+//	latest := quando.Max(a, b, c)
+func Max(first Date, rest ...Date) Date {
+	result := first
+	for _, d := range rest {
+		if d.After(result) {
+			result = d
+		}
+	}
+	return result
+}
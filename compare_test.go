@@ -0,0 +1,181 @@
+package quando
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestBeforeAfterEqual(t *testing.T) {
+	earlier := From(time.Date(2026, 2, 9, 12, 0, 0, 0, time.UTC))
+	later := From(time.Date(2026, 2, 9, 12, 0, 1, 0, time.UTC))
+	sameInstant := From(time.Date(2026, 2, 9, 13, 0, 0, 0, time.FixedZone("CET", 3600))).WithLang(DE)
+
+	tests := []struct {
+		name   string
+		a, b   Date
+		before bool
+		after  bool
+		equal  bool
+	}{
+		{"earlier vs later", earlier, later, true, false, false},
+		{"later vs earlier", later, earlier, false, true, false},
+		{"same date", earlier, earlier, false, false, true},
+		{"same instant, different zone and lang", earlier, sameInstant, false, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Before(tt.b); got != tt.before {
+				t.Errorf("Before() = %v, want %v", got, tt.before)
+			}
+			if got := tt.a.After(tt.b); got != tt.after {
+				t.Errorf("After() = %v, want %v", got, tt.after)
+			}
+			if got := tt.a.Equal(tt.b); got != tt.equal {
+				t.Errorf("Equal() = %v, want %v", got, tt.equal)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	a := From(time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC))
+	b := From(time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC))
+
+	if got := a.Compare(b); got != -1 {
+		t.Errorf("Compare(earlier, later) = %d, want -1", got)
+	}
+	if got := b.Compare(a); got != 1 {
+		t.Errorf("Compare(later, earlier) = %d, want 1", got)
+	}
+	if got := a.Compare(a.WithLang(DE)); got != 0 {
+		t.Errorf("Compare(same) = %d, want 0", got)
+	}
+}
+
+func TestCompareSortFunc(t *testing.T) {
+	dates := []Date{
+		MustParse("2026-03-01"),
+		MustParse("2025-12-31"),
+		MustParse("2026-02-09"),
+		MustParse("2026-01-15"),
+	}
+
+	slices.SortFunc(dates, Date.Compare)
+
+	expected := []string{"2025-12-31", "2026-01-15", "2026-02-09", "2026-03-01"}
+	for i, d := range dates {
+		if d.Format(ISO) != expected[i] {
+			t.Errorf("sorted[%d] = %v, want %v", i, d.Format(ISO), expected[i])
+		}
+	}
+}
+
+func TestIsSame(t *testing.T) {
+	base := From(time.Date(2026, 2, 11, 14, 30, 45, 500, time.UTC)) // Wednesday
+
+	tests := []struct {
+		name     string
+		other    Date
+		unit     Unit
+		expected bool
+	}{
+		{"same second", From(time.Date(2026, 2, 11, 14, 30, 45, 999, time.UTC)), Seconds, true},
+		{"different second", From(time.Date(2026, 2, 11, 14, 30, 46, 0, time.UTC)), Seconds, false},
+		{"same minute", From(time.Date(2026, 2, 11, 14, 30, 0, 0, time.UTC)), Minutes, true},
+		{"different minute", From(time.Date(2026, 2, 11, 14, 31, 0, 0, time.UTC)), Minutes, false},
+		{"same hour", From(time.Date(2026, 2, 11, 14, 59, 59, 0, time.UTC)), Hours, true},
+		{"different hour", From(time.Date(2026, 2, 11, 15, 0, 0, 0, time.UTC)), Hours, false},
+		{"same day", From(time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC)), Days, true},
+		{"different day", From(time.Date(2026, 2, 12, 0, 0, 0, 0, time.UTC)), Days, false},
+		{"same week (Monday)", From(time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC)), Weeks, true},
+		{"same week (Sunday)", From(time.Date(2026, 2, 15, 23, 59, 59, 0, time.UTC)), Weeks, true},
+		{"different week", From(time.Date(2026, 2, 16, 0, 0, 0, 0, time.UTC)), Weeks, false},
+		{"same month", From(time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)), Months, true},
+		{"different month", From(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)), Months, false},
+		{"same month, different year", From(time.Date(2025, 2, 11, 0, 0, 0, 0, time.UTC)), Months, false},
+		{"same quarter", From(time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)), Quarters, true},
+		{"different quarter", From(time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)), Quarters, false},
+		{"same year", From(time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)), Years, true},
+		{"different year", From(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)), Years, false},
+		{"same half-year", From(time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC)), HalfYears, true},
+		{"different half-year", From(time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)), HalfYears, false},
+		{"same decade", From(time.Date(2029, 12, 31, 0, 0, 0, 0, time.UTC)), Decades, true},
+		{"different decade", From(time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC)), Decades, false},
+		{"same century", From(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)), Centuries, true},
+		{"same millisecond", From(time.Date(2026, 2, 11, 14, 30, 45, 999, time.UTC)), Milliseconds, true},
+		{"different nanosecond", From(time.Date(2026, 2, 11, 14, 30, 45, 999, time.UTC)), Nanoseconds, false},
+		{"unknown unit", base, Unit(99), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := base.IsSame(tt.other, tt.unit); got != tt.expected {
+				t.Errorf("IsSame(%v, %v) = %v, want %v", tt.other, tt.unit, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsSameTimezone(t *testing.T) {
+	// 23:30 UTC on Feb 9 is already Feb 10 in Berlin
+	utc := From(time.Date(2026, 2, 9, 23, 30, 0, 0, time.UTC))
+	berlin := From(time.Date(2026, 2, 10, 8, 0, 0, 0, time.FixedZone("CET", 3600)))
+
+	if utc.IsSame(berlin, Days) {
+		t.Error("IsSame(Days) in UTC should be false")
+	}
+	if !berlin.IsSame(utc, Days) {
+		t.Error("IsSame(Days) in CET should be true")
+	}
+}
+
+func TestIsSameHourDST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	// 02:30 occurs twice on 2026-10-25 in Berlin (CEST, then CET)
+	first := From(time.Date(2026, 10, 25, 0, 30, 0, 0, time.UTC).In(berlin))
+	second := From(time.Date(2026, 10, 25, 1, 30, 0, 0, time.UTC).In(berlin))
+
+	if first.Time().Hour() != 2 || second.Time().Hour() != 2 {
+		t.Fatalf("test setup: hours = %d, %d, want 2, 2", first.Time().Hour(), second.Time().Hour())
+	}
+	if first.IsSame(second, Hours) {
+		t.Error("IsSame(Hours) for the two occurrences of 02:30 should be false")
+	}
+	if !first.IsSame(second, Days) {
+		t.Error("IsSame(Days) for the two occurrences of 02:30 should be true")
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	a := MustParse("2026-02-09")
+	b := MustParse("2026-01-01")
+	c := MustParse("2026-12-31")
+
+	if got := Min(a, b, c); !got.Equal(b) {
+		t.Errorf("Min() = %v, want %v", got, b)
+	}
+	if got := Max(a, b, c); !got.Equal(c) {
+		t.Errorf("Max() = %v, want %v", got, c)
+	}
+	if got := Min(a); !got.Equal(a) {
+		t.Errorf("Min(single) = %v, want %v", got, a)
+	}
+	if got := Max(a); !got.Equal(a) {
+		t.Errorf("Max(single) = %v, want %v", got, a)
+	}
+
+	// Ties return the first argument
+	de := a.WithLang(DE)
+	if got := Min(de, a); got.lang != DE {
+		t.Errorf("Min() tie lang = %v, want %v", got.lang, DE)
+	}
+	if got := Max(de, a); got.lang != DE {
+		t.Errorf("Max() tie lang = %v, want %v", got.lang, DE)
+	}
+}
@@ -0,0 +1,478 @@
+package quando
+
+import (
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronField is the set of values allowed in one field of a cron expression,
+// stored as a bit set.
+type cronField uint64
+
+// has reports whether v is in the set.
+func (f cronField) has(v int) bool {
+	return f&(1<<uint(v)) != 0
+}
+
+// cronBounds describes the range and value names of a cron field.
+type cronBounds struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	cronSeconds = cronBounds{name: "second", min: 0, max: 59}
+	cronMinutes = cronBounds{name: "minute", min: 0, max: 59}
+	cronHours   = cronBounds{name: "hour", min: 0, max: 23}
+	cronDays    = cronBounds{name: "day of month", min: 1, max: 31}
+	cronMonths  = cronBounds{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}}
+	// Day of week 7 is an alias for Sunday (0)
+	cronWeekdays = cronBounds{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}}
+)
+
+// cronMacros maps the supported @-macros to their five-field expressions.
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Cron is a parsed cron expression that computes fire times.
+//
+// Create schedules with ParseCron. Cron is a value type and safe for
+// concurrent use.
+//
+// Fire times are wall clock times in the schedule's location: the location
+// given with a CRON_TZ= prefix, or else the location of the date passed to
+// Next or Prev. Around DST transitions, Cron follows the behavior of Vixie
+// cron:
+//   - Times skipped when clocks spring forward: schedules with a fixed hour
+//     fire once at the moment of the transition (e.g. "30 2 * * *" fires at
+//     03:00 CEST on the last Sunday of March in Europe/Berlin); schedules
+//     with a wildcard hour simply skip the nonexistent times.
+//   - Times repeated when clocks fall back: schedules with a fixed hour fire
+//     only at the first occurrence; schedules with a wildcard hour fire at
+//     both.
+type Cron struct {
+	spec    string
+	second  cronField
+	minute  cronField
+	hour    cronField
+	dom     cronField
+	month   cronField
+	dow     cronField
+	domStar bool // day of month field is "*" or "?"
+	dowStar bool // day of week field is "*" or "?"
+	loc     *time.Location
+}
+
+// ParseCron parses a cron expression.
+//
+// Supported formats:
+//   - Five fields: "minute hour day-of-month month day-of-week"
+//   - Six fields: "second minute hour day-of-month month day-of-week"
+//   - Macros: @yearly (@annually), @monthly, @weekly, @daily (@midnight), @hourly
+//
+// Each field accepts "*", single values, ranges ("1-5"), steps ("*/15",
+// "0-30/10", "5/15") and comma-separated lists of these. Months and weekdays
+// also accept three-letter English names ("JAN", "MON"); Sunday is 0 or 7.
+// "?" is accepted as an alias for "*" in the day fields.
+//
+// As in Vixie cron, if both day-of-month and day-of-week are restricted
+// (neither is "*"), a day matches if either field matches.
+//
+// An optional "CRON_TZ=<IANA name> " (or "TZ=") prefix fixes the location in
+// which the schedule is evaluated.
+//
+// Returns ErrInvalidFormat if the expression is malformed or can never fire
+// (e.g. "0 0 30 2 *"), and ErrInvalidTimezone if the location is unknown.
+//
+// Example:
+//
+//	schedule, err := quando.ParseCron("CRON_TZ=Europe/Berlin 30 8 * * MON-FRI")
+//	if err != nil {
+//	    return err
+//	}
+func ParseCron(spec string) (Cron, error) {
+	c := Cron{spec: strings.TrimSpace(spec)}
+	expr := c.spec
+
+	if prefix, rest, ok := strings.Cut(expr, " "); ok &&
+		(strings.HasPrefix(prefix, "CRON_TZ=") || strings.HasPrefix(prefix, "TZ=")) {
+		_, name, _ := strings.Cut(prefix, "=")
+		loc, err := time.LoadLocation(name)
+		if err != nil || name == "" {
+			return Cron{}, fmt.Errorf("parsing cron expression %q: loading timezone %q: %w", spec, name, ErrInvalidTimezone)
+		}
+		c.loc = loc
+		expr = strings.TrimSpace(rest)
+	}
+
+	if strings.HasPrefix(expr, "@") {
+		macro, ok := cronMacros[strings.ToLower(expr)]
+		if !ok {
+			return Cron{}, fmt.Errorf("parsing cron expression %q: unknown macro %q: %w", spec, expr, ErrInvalidFormat)
+		}
+		expr = macro
+	}
+
+	fields := strings.Fields(expr)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return Cron{}, fmt.Errorf("parsing cron expression %q: expected 5 or 6 fields, got %d: %w", spec, len(fields), ErrInvalidFormat)
+	}
+
+	var err error
+	targets := []*cronField{&c.second, &c.minute, &c.hour, &c.dom, &c.month, &c.dow}
+	bounds := []cronBounds{cronSeconds, cronMinutes, cronHours, cronDays, cronMonths, cronWeekdays}
+	for i, field := range fields {
+		if *targets[i], err = parseCronField(field, bounds[i]); err != nil {
+			return Cron{}, fmt.Errorf("parsing cron expression %q: %v: %w", spec, err, ErrInvalidFormat)
+		}
+	}
+
+	// Sunday may be written as 7
+	if c.dow.has(7) {
+		c.dow = c.dow&^(1<<7) | 1<<0
+	}
+	c.domStar = fields[3] == "*" || fields[3] == "?" || strings.HasPrefix(fields[3], "*/")
+	c.dowStar = fields[5] == "*" || fields[5] == "?" || strings.HasPrefix(fields[5], "*/")
+
+	if !c.satisfiable() {
+		return Cron{}, fmt.Errorf("parsing cron expression %q: day of month never occurs in the given months: %w", spec, ErrInvalidFormat)
+	}
+	return c, nil
+}
+
+// MustParseCron is like ParseCron but panics if the expression cannot be
+// parsed. It simplifies safe initialization of global variables.
+//
+// Example:
+//
+//	var nightly = quando.MustParseCron("0 3 * * *")
+func MustParseCron(spec string) Cron {
+	c, err := ParseCron(spec)
+	if err != nil {
+		panic(fmt.Sprintf("quando.MustParseCron(%q): %v", spec, err))
+	}
+	return c
+}
+
+// parseCronField parses one field of a cron expression into a bit set.
+func parseCronField(field string, b cronBounds) (cronField, error) {
+	var set cronField
+	for item := range strings.SplitSeq(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+
+		lo, hi := b.min, b.max
+		switch {
+		case rangePart == "*" || (rangePart == "?" && (b.name == cronDays.name || b.name == cronWeekdays.name)):
+			if b.name == cronWeekdays.name {
+				hi = 6 // Sunday is already included as 0
+			}
+		default:
+			from, to, isRange := strings.Cut(rangePart, "-")
+			var err error
+			if lo, err = parseCronValue(from, b); err != nil {
+				return 0, err
+			}
+			switch {
+			case isRange:
+				if hi, err = parseCronValue(to, b); err != nil {
+					return 0, err
+				}
+			case !hasStep:
+				hi = lo
+			}
+		}
+		if lo > hi {
+			return 0, fmt.Errorf("invalid %s range %q", b.name, item)
+		}
+
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepPart)
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("invalid %s step %q", b.name, item)
+			}
+			step = n
+		}
+
+		for v := lo; v <= hi; v += step {
+			set |= 1 << uint(v)
+		}
+	}
+	return set, nil
+}
+
+// parseCronValue parses a single number or name of a cron field.
+func parseCronValue(s string, b cronBounds) (int, error) {
+	if v, ok := b.names[strings.ToUpper(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < b.min || v > b.max {
+		return 0, fmt.Errorf("invalid %s %q", b.name, s)
+	}
+	return v, nil
+}
+
+// satisfiable reports whether the day fields can match any day in the
+// allowed months. Only a restricted day of month combined with an
+// unrestricted day of week can be impossible, e.g. "0 0 30 2 *".
+func (c Cron) satisfiable() bool {
+	if c.domStar || !c.dowStar {
+		return true
+	}
+	// Longest length of each month, including February 29
+	lengths := [...]int{0, 31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+	for m := 1; m <= 12; m++ {
+		if !c.month.has(m) {
+			continue
+		}
+		for d := 1; d <= lengths[m]; d++ {
+			if c.dom.has(d) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Next returns the first fire time strictly after the given date, in the
+// schedule's location. Returns the zero Date if the schedule does not fire
+// again before year 10000.
+//
+// Example:
+//
+//	schedule := quando.MustParseCron("0 9 * * MON")
+//	after := quando.MustParse("2026-02-09 09:00:00") // Monday
+//	next := schedule.Next(after)                     // 2026-02-16 09:00:00
+func (c Cron) Next(after Date) Date {
+	loc := c.locationFor(after)
+	t := after.t.In(loc)
+	from := wallClock(t).Add(time.Nanosecond)
+
+	// Search zone segment by zone segment: within a segment the UTC offset is
+	// constant, so wall clock times map one-to-one to instants.
+	for {
+		_, offset := t.Zone()
+		_, end := t.ZoneBounds()
+
+		w, ok := c.nextWall(from)
+		if !ok {
+			return Date{}
+		}
+		instant := w.Add(-time.Duration(offset) * time.Second).In(loc)
+
+		if end.IsZero() || instant.Before(end) {
+			if c.isRepeated(instant) {
+				t, from = instant, w.Add(time.Nanosecond)
+				continue
+			}
+			return after.with(instant)
+		}
+
+		t = end.In(loc)
+		if c.firesInGap(t) {
+			return after.with(t)
+		}
+		from = wallClock(t)
+	}
+}
+
+// Prev returns the last fire time strictly before the given date, in the
+// schedule's location. Returns the zero Date if there is none after year 1.
+//
+// Example:
+//
+//	schedule := quando.MustParseCron("0 9 * * MON")
+//	before := quando.MustParse("2026-02-09 09:00:00") // Monday
+//	prev := schedule.Prev(before)                     // 2026-02-02 09:00:00
+func (c Cron) Prev(before Date) Date {
+	loc := c.locationFor(before)
+	t := before.t.In(loc)
+	upTo := wallClock(t).Add(-time.Nanosecond)
+
+	for {
+		_, offset := t.Zone()
+		start, _ := t.ZoneBounds()
+
+		w, ok := c.prevWall(upTo)
+		if !ok {
+			return Date{}
+		}
+		instant := w.Add(-time.Duration(offset) * time.Second).In(loc)
+
+		if start.IsZero() || !instant.Before(start) {
+			if c.isRepeated(instant) {
+				t, upTo = instant, w.Add(-time.Nanosecond)
+				continue
+			}
+			return before.with(instant)
+		}
+
+		start = start.In(loc)
+		if start.Before(before.t) && c.firesInGap(start) {
+			return before.with(start)
+		}
+		t = start.Add(-time.Nanosecond)
+		upTo = wallClock(t)
+	}
+}
+
+// Upcoming returns an iterator over the fire times after the current time of
+// the given clock. Use NewFixedClock in tests for deterministic results.
+//
+// The iterator is unbounded, so stop iterating with break.
+//
+// Example:
+//
+//	schedule := quando.MustParseCron("CRON_TZ=Europe/Berlin 0 9 * * MON-FRI")
+//	for next := range schedule.Upcoming(quando.NewClock()) {
+//	    fmt.Println(next)
+//	    break
+//	}
+func (c Cron) Upcoming(clock Clock) iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		next := clock.Now()
+		for {
+			next = c.Next(next)
+			if next.t.IsZero() || !yield(next) {
+				return
+			}
+		}
+	}
+}
+
+// String returns the cron expression as it was parsed.
+func (c Cron) String() string {
+	return c.spec
+}
+
+// locationFor returns the location in which the schedule is evaluated.
+func (c Cron) locationFor(d Date) *time.Location {
+	if c.loc != nil {
+		return c.loc
+	}
+	return d.t.Location()
+}
+
+// matchesDay reports whether the calendar day of the wall clock time w
+// matches the day-of-month, month and day-of-week fields.
+func (c Cron) matchesDay(w time.Time) bool {
+	if !c.month.has(int(w.Month())) {
+		return false
+	}
+	dom, dow := c.dom.has(w.Day()), c.dow.has(int(w.Weekday()))
+	if c.domStar || c.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// nextWall returns the earliest wall clock time at or after w (both
+// represented in UTC) that matches the expression.
+func (c Cron) nextWall(w time.Time) (time.Time, bool) {
+	t := w
+	if ns := t.Nanosecond(); ns != 0 {
+		t = t.Add(time.Second - time.Duration(ns))
+	}
+
+	for t.Year() <= 9999 {
+		switch {
+		case !c.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case !c.hour.has(t.Hour()):
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case !c.minute.has(t.Minute()):
+			t = t.Truncate(time.Minute).Add(time.Minute)
+		case !c.second.has(t.Second()):
+			t = t.Add(time.Second)
+		default:
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// prevWall returns the latest wall clock time at or before w (both
+// represented in UTC) that matches the expression.
+func (c Cron) prevWall(w time.Time) (time.Time, bool) {
+	t := w.Truncate(time.Second)
+
+	for t.Year() >= 1 {
+		switch {
+		case !c.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Add(-time.Second)
+		case !c.hour.has(t.Hour()):
+			t = t.Truncate(time.Hour).Add(-time.Second)
+		case !c.minute.has(t.Minute()):
+			t = t.Truncate(time.Minute).Add(-time.Second)
+		case !c.second.has(t.Second()):
+			t = t.Add(-time.Second)
+		default:
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// allHours reports whether the hour field is unrestricted.
+func (c Cron) allHours() bool {
+	return c.hour == 1<<24-1
+}
+
+// isRepeated reports whether instant is the second occurrence of a wall clock
+// time repeated when clocks fall back, and the schedule has a fixed hour.
+func (c Cron) isRepeated(instant time.Time) bool {
+	if c.allHours() {
+		return false
+	}
+	start, _ := instant.ZoneBounds()
+	if start.IsZero() {
+		return false
+	}
+	_, offset := instant.Zone()
+	_, prevOffset := start.Add(-time.Nanosecond).Zone()
+	return prevOffset > offset && instant.Sub(start) < time.Duration(prevOffset-offset)*time.Second
+}
+
+// firesInGap reports whether a schedule with a fixed hour would have fired in
+// the wall clock times skipped by a forward transition starting at start.
+func (c Cron) firesInGap(start time.Time) bool {
+	if c.allHours() {
+		return false
+	}
+	_, offset := start.Zone()
+	before := start.Add(-time.Nanosecond)
+	_, prevOffset := before.Zone()
+	if offset <= prevOffset {
+		return false
+	}
+
+	gapStart := wallClock(before).Add(time.Nanosecond)
+	w, ok := c.nextWall(gapStart)
+	return ok && w.Before(wallClock(start))
+}
+
+// wallClock returns the wall clock time of t represented in UTC.
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
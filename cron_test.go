@@ -0,0 +1,325 @@
+package quando
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCronNextPrev(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+		date string
+		next string
+		prev string
+	}{
+		{"weekly on Monday", "0 9 * * MON", "2026-02-09 09:00:00", "2026-02-16 09:00:00", "2026-02-02 09:00:00"},
+		{"every 15 minutes", "*/15 * * * *", "2026-02-09 10:07:00", "2026-02-09 10:15:00", "2026-02-09 10:00:00"},
+		{"on the boundary", "*/15 * * * *", "2026-02-09 10:15:00", "2026-02-09 10:30:00", "2026-02-09 10:00:00"},
+		{"hour range with step", "0 8-18/2 * * *", "2026-02-09 18:30:00", "2026-02-10 08:00:00", "2026-02-09 18:00:00"},
+		{"list of minutes", "5,35 * * * *", "2026-02-09 10:10:00", "2026-02-09 10:35:00", "2026-02-09 10:05:00"},
+		{"seconds field", "30 * * * * *", "2026-02-09 10:00:00", "2026-02-09 10:00:30", "2026-02-09 09:59:30"},
+		{"every second", "* * * * * *", "2026-02-09 23:59:59", "2026-02-10 00:00:00", "2026-02-09 23:59:58"},
+		{"weekdays", "30 8 * * MON-FRI", "2026-02-13 09:00:00", "2026-02-16 08:30:00", "2026-02-13 08:30:00"},
+		{"Sunday as 7", "0 0 * * 7", "2026-02-09 00:00:00", "2026-02-15 00:00:00", "2026-02-08 00:00:00"},
+		{"month names", "0 0 1 JAN,JUL *", "2026-02-09 00:00:00", "2026-07-01 00:00:00", "2026-01-01 00:00:00"},
+		{"leap day", "0 0 29 2 *", "2026-02-09 00:00:00", "2028-02-29 00:00:00", "2024-02-29 00:00:00"},
+		{"day of month OR day of week", "0 0 13 * FRI", "2026-02-13 00:00:00", "2026-02-20 00:00:00", "2026-02-06 00:00:00"},
+		{"day of month only", "0 0 13 * *", "2026-02-13 00:00:00", "2026-03-13 00:00:00", "2026-01-13 00:00:00"},
+		{"day of week with stepped day of month", "0 0 */2 * MON", "2026-02-09 00:00:00", "2026-02-23 00:00:00", "2026-01-19 00:00:00"},
+		{"question mark", "0 12 ? * SAT", "2026-02-09 00:00:00", "2026-02-14 12:00:00", "2026-02-07 12:00:00"},
+		{"@daily", "@daily", "2026-02-09 12:00:00", "2026-02-10 00:00:00", "2026-02-09 00:00:00"},
+		{"@midnight", "@midnight", "2026-02-09 12:00:00", "2026-02-10 00:00:00", "2026-02-09 00:00:00"},
+		{"@hourly", "@hourly", "2026-02-09 12:30:00", "2026-02-09 13:00:00", "2026-02-09 12:00:00"},
+		{"@weekly", "@weekly", "2026-02-09 12:00:00", "2026-02-15 00:00:00", "2026-02-08 00:00:00"},
+		{"@monthly", "@monthly", "2026-02-09 12:00:00", "2026-03-01 00:00:00", "2026-02-01 00:00:00"},
+		{"@yearly", "@yearly", "2026-02-09 12:00:00", "2027-01-01 00:00:00", "2026-01-01 00:00:00"},
+		{"@annually", "@ANNUALLY", "2026-02-09 12:00:00", "2027-01-01 00:00:00", "2026-01-01 00:00:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseCron(tt.spec)
+			if err != nil {
+				t.Fatalf("ParseCron(%q) error = %v", tt.spec, err)
+			}
+			date := MustParse(tt.date)
+			if got := c.Next(date).String(); got != tt.next {
+				t.Errorf("Next(%v) = %v, want %v", tt.date, got, tt.next)
+			}
+			if got := c.Prev(date).String(); got != tt.prev {
+				t.Errorf("Prev(%v) = %v, want %v", tt.date, got, tt.prev)
+			}
+		})
+	}
+}
+
+func TestCronNextSubSecond(t *testing.T) {
+	c := MustParseCron("* * * * * *")
+	date := From(time.Date(2026, 2, 9, 10, 0, 0, 500, time.UTC))
+
+	if got := c.Next(date).String(); got != "2026-02-09 10:00:01" {
+		t.Errorf("Next() = %v, want 2026-02-09 10:00:01", got)
+	}
+	if got := c.Prev(date).String(); got != "2026-02-09 10:00:00" {
+		t.Errorf("Prev() = %v, want 2026-02-09 10:00:00", got)
+	}
+}
+
+func TestCronPreservesLang(t *testing.T) {
+	c := MustParseCron("@daily")
+	date := MustParse("2026-02-09").WithLang(DE)
+
+	if got := c.Next(date); got.lang != DE {
+		t.Errorf("Next() lang = %v, want %v", got.lang, DE)
+	}
+	if got := c.Prev(date); got.lang != DE {
+		t.Errorf("Prev() lang = %v, want %v", got.lang, DE)
+	}
+}
+
+func TestCronTimezone(t *testing.T) {
+	c, err := ParseCron("CRON_TZ=Europe/Berlin 0 9 * * *")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	// 12:00 UTC is 13:00 in Berlin, so the next run is tomorrow 09:00 CET
+	next := c.Next(MustParse("2026-02-09 12:00:00"))
+	if next.Time().Location().String() != "Europe/Berlin" {
+		t.Errorf("Next() location = %v, want Europe/Berlin", next.Time().Location())
+	}
+	if expected := time.Date(2026, 2, 10, 8, 0, 0, 0, time.UTC); !next.Time().Equal(expected) {
+		t.Errorf("Next() = %v, want %v", next.Time(), expected)
+	}
+
+	// Without CRON_TZ, the location of the date is used
+	berlin := next.Time().Location()
+	plain := MustParseCron("0 9 * * *")
+	date := From(time.Date(2026, 2, 9, 13, 0, 0, 0, berlin))
+	if got := plain.Next(date); !got.Time().Equal(next.Time()) {
+		t.Errorf("Next() in date location = %v, want %v", got.Time(), next.Time())
+	}
+}
+
+func TestCronDST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+	at := func(month time.Month, day, hour, min int, zone string) time.Time {
+		offset := 1
+		if zone == "CEST" {
+			offset = 2
+		}
+		return time.Date(2026, month, day, hour-offset, min, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		spec     string
+		from     time.Time
+		next     time.Time
+		nextNext time.Time
+	}{
+		{
+			// 02:30 does not exist on March 29; fixed-hour jobs run at the transition
+			"spring forward, fixed hour",
+			"30 2 * * *",
+			at(time.March, 28, 3, 0, "CET"),
+			at(time.March, 29, 3, 0, "CEST"),
+			at(time.March, 30, 2, 30, "CEST"),
+		},
+		{
+			"spring forward, wildcard hour",
+			"*/30 * * * *",
+			at(time.March, 29, 1, 45, "CET"),
+			at(time.March, 29, 3, 0, "CEST"),
+			at(time.March, 29, 3, 30, "CEST"),
+		},
+		{
+			"spring forward, outside the gap",
+			"0 4 * * *",
+			at(time.March, 28, 5, 0, "CET"),
+			at(time.March, 29, 4, 0, "CEST"),
+			at(time.March, 30, 4, 0, "CEST"),
+		},
+		{
+			// 02:30 occurs twice on October 25; fixed-hour jobs run once
+			"fall back, fixed hour",
+			"30 2 * * *",
+			at(time.October, 25, 0, 0, "CEST"),
+			at(time.October, 25, 2, 30, "CEST"),
+			at(time.October, 26, 2, 30, "CET"),
+		},
+		{
+			"fall back, wildcard hour",
+			"30 * * * *",
+			at(time.October, 25, 2, 0, "CEST"),
+			at(time.October, 25, 2, 30, "CEST"),
+			at(time.October, 25, 2, 30, "CET"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := MustParseCron(tt.spec)
+			from := From(tt.from.In(berlin))
+
+			next := c.Next(from)
+			if !next.Time().Equal(tt.next) {
+				t.Errorf("Next(%v) = %v, want %v", from.Time(), next.Time(), tt.next.In(berlin))
+			}
+			nextNext := c.Next(next)
+			if !nextNext.Time().Equal(tt.nextNext) {
+				t.Errorf("Next(%v) = %v, want %v", next.Time(), nextNext.Time(), tt.nextNext.In(berlin))
+			}
+
+			// Prev walks the same fire times backwards
+			if got := c.Prev(nextNext); !got.Time().Equal(tt.next) {
+				t.Errorf("Prev(%v) = %v, want %v", nextNext.Time(), got.Time(), tt.next.In(berlin))
+			}
+		})
+	}
+}
+
+// TestCronNextPrevConsistent verifies that Prev yields exactly the fire times
+// of Next in reverse, across both DST transitions of a year
+func TestCronNextPrevConsistent(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	specs := []string{"30 2 * * *", "*/20 * * * *", "0 1-3 * * *", "15 2,3 * * SUN", "@hourly"}
+	windows := []time.Time{
+		time.Date(2026, 3, 28, 12, 0, 0, 0, berlin),
+		time.Date(2026, 10, 24, 12, 0, 0, 0, berlin),
+	}
+
+	for _, spec := range specs {
+		for _, start := range windows {
+			c := MustParseCron(spec)
+			end := From(start.Add(48 * time.Hour))
+
+			var forward []time.Time
+			for d := c.Next(From(start)); d.Time().Before(end.Time()); d = c.Next(d) {
+				forward = append(forward, d.Time())
+			}
+
+			var backward []time.Time
+			for d := c.Prev(end); d.Time().After(start); d = c.Prev(d) {
+				backward = append(backward, d.Time())
+			}
+			slices.Reverse(backward)
+
+			if !slices.EqualFunc(forward, backward, time.Time.Equal) {
+				t.Errorf("%q from %v: Next = %v, Prev reversed = %v", spec, start, forward, backward)
+			}
+			for i := 1; i < len(forward); i++ {
+				if !forward[i].After(forward[i-1]) {
+					t.Errorf("%q: fire times not increasing at %v", spec, forward[i])
+				}
+			}
+		}
+	}
+}
+
+func TestCronUpcoming(t *testing.T) {
+	clock := NewFixedClock(time.Date(2026, 2, 9, 12, 0, 0, 0, time.UTC))
+	c := MustParseCron("0 9 * * MON,WED")
+
+	var got []string
+	for d := range c.Upcoming(clock) {
+		got = append(got, d.String())
+		if len(got) == 3 {
+			break
+		}
+	}
+
+	expected := []string{"2026-02-11 09:00:00", "2026-02-16 09:00:00", "2026-02-18 09:00:00"}
+	if !slices.Equal(got, expected) {
+		t.Errorf("Upcoming() = %v, want %v", got, expected)
+	}
+}
+
+func TestCronEndOfTime(t *testing.T) {
+	c := MustParseCron("@yearly")
+
+	if got := c.Next(MustParse("9999-06-01")); !got.Time().IsZero() {
+		t.Errorf("Next() after last fire time = %v, want zero Date", got)
+	}
+	if got := c.Prev(From(time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC))); !got.Time().IsZero() {
+		t.Errorf("Prev() before first fire time = %v, want zero Date", got)
+	}
+}
+
+func TestParseCronErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr error
+	}{
+		{"empty", "", ErrInvalidFormat},
+		{"too few fields", "0 9 * *", ErrInvalidFormat},
+		{"too many fields", "0 0 9 * * * *", ErrInvalidFormat},
+		{"unknown macro", "@reboot", ErrInvalidFormat},
+		{"minute out of range", "60 * * * *", ErrInvalidFormat},
+		{"hour out of range", "0 24 * * *", ErrInvalidFormat},
+		{"day zero", "0 0 0 * *", ErrInvalidFormat},
+		{"month out of range", "0 0 1 13 *", ErrInvalidFormat},
+		{"weekday out of range", "0 0 * * 8", ErrInvalidFormat},
+		{"unknown name", "0 0 * * FUNDAY", ErrInvalidFormat},
+		{"reversed range", "0 0 * * FRI-MON", ErrInvalidFormat},
+		{"zero step", "*/0 * * * *", ErrInvalidFormat},
+		{"invalid step", "*/x * * * *", ErrInvalidFormat},
+		{"empty list item", "1,,2 * * * *", ErrInvalidFormat},
+		{"question mark outside day fields", "? * * * *", ErrInvalidFormat},
+		{"impossible day", "0 0 30 2 *", ErrInvalidFormat},
+		{"impossible day in months", "0 0 31 4,6,9,11 *", ErrInvalidFormat},
+		{"unknown timezone", "CRON_TZ=Mars/Olympus 0 9 * * *", ErrInvalidTimezone},
+		{"empty timezone", "CRON_TZ= 0 9 * * *", ErrInvalidTimezone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCron(tt.spec)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseCron(%q) error = %v, want %v", tt.spec, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseCronValid(t *testing.T) {
+	specs := []string{
+		"0 0 31 2 MON", // day of week makes it satisfiable
+		"0 0 29 2 *",   // leap years only
+		"0 0 * * 0-7",  // 7 is Sunday
+		"5/15 * * * *", // start with step
+		"0 0 1-31/10 jan-dec sun-sat",
+		"TZ=UTC @daily", // TZ prefix and macro
+	}
+
+	for _, spec := range specs {
+		c, err := ParseCron(spec)
+		if err != nil {
+			t.Errorf("ParseCron(%q) error = %v", spec, err)
+			continue
+		}
+		if c.String() != spec {
+			t.Errorf("String() = %q, want %q", c.String(), spec)
+		}
+	}
+}
+
+func TestMustParseCronPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseCron() did not panic on invalid input")
+		}
+	}()
+	MustParseCron("not a cron spec")
+}
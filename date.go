@@ -65,8 +65,18 @@ const (
 // The Date type supports the full range of Go's time.Time (approximately
 // year 0001 to year 9999, with extensions beyond that range).
 type Date struct {
-	t    time.Time
-	lang Lang
+	t         time.Time
+	lang      Lang
+	weekStart int8           // days after Monday on which weeks start; 0 = Monday (ISO 8601)
+	weekend   Weekend        // weekend days; zero value = Saturday and Sunday
+	monthEnd  MonthEndPolicy // month-end handling of Add; zero value = MonthEndClamp
+}
+
+// with returns a copy of d with the time replaced, keeping the language and
+// calendar settings.
+func (d Date) with(t time.Time) Date {
+	d.t = t
+	return d
 }
 
 // Now returns a Date representing the current moment in time.
@@ -132,6 +142,18 @@ func (d Date) Unix() int64 {
 	return d.t.Unix()
 }
 
+// IsZero reports whether the date is the zero Date (January 1, year 1,
+// 00:00:00 UTC). Methods that cannot produce a meaningful result, such as
+// StartOf with an unknown unit, return the zero Date.
+//
+// Example:
+//
+//	var d quando.Date
+//	d.IsZero() // true
+func (d Date) IsZero() bool {
+	return d.t.IsZero()
+}
+
 // WithLang returns a new Date with the specified language for formatting.
 // This does not modify the date or time, only the language used for formatting operations.
 //
@@ -139,10 +161,8 @@ func (d Date) Unix() int64 {
 //
 //	date := quando.Now().WithLang(quando.DE)
 func (d Date) WithLang(lang Lang) Date {
-	return Date{
-		t:    d.t,
-		lang: lang,
-	}
+	d.lang = lang
+	return d
 }
 
 // In converts the date to the specified IANA timezone.
@@ -176,11 +196,8 @@ func (d Date) In(location string) (Date, error) {
 	// Convert time to new timezone
 	converted := d.t.In(loc)
 
-	// Return new Date with converted time, preserving language
-	return Date{
-		t:    converted,
-		lang: d.lang,
-	}, nil
+	// Return new Date with converted time, preserving language and settings
+	return d.with(converted), nil
 }
 
 // String returns the ISO 8601 representation of the date (YYYY-MM-DD HH:MM:SS).
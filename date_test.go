@@ -501,3 +501,19 @@ func TestDSTSafeArithmetic(t *testing.T) {
 		t.Logf("This is correct: DST spring forward skips 1 hour")
 	}
 }
+
+func TestIsZero(t *testing.T) {
+	var zero Date
+	if !zero.IsZero() {
+		t.Error("Date{}.IsZero() = false, want true")
+	}
+	if Now().IsZero() {
+		t.Error("Now().IsZero() = true, want false")
+	}
+	if !From(time.Time{}).IsZero() {
+		t.Error("From(time.Time{}).IsZero() = false, want true")
+	}
+	if !Now().StartOf(Unit(99)).IsZero() {
+		t.Error("StartOf(unknown unit).IsZero() = false, want true")
+	}
+}
@@ -14,8 +14,9 @@ import (
 //
 // Durations can be negative if the start date is after the end date.
 type Duration struct {
-	start time.Time
-	end   time.Time
+	start   time.Time
+	end     time.Time
+	weekend Weekend // weekend used by BusinessDays; zero value = Saturday and Sunday
 }
 
 // Diff calculates the duration between two dates.
@@ -34,6 +35,23 @@ func Diff(a, b time.Time) Duration {
 	}
 }
 
+// Nanoseconds returns the number of nanoseconds in the duration.
+// Like time.Time.Sub, the result saturates for spans of more than about
+// 292 years.
+func (d Duration) Nanoseconds() int64 {
+	return d.end.Sub(d.start).Nanoseconds()
+}
+
+// Microseconds returns the number of microseconds in the duration (rounded down).
+func (d Duration) Microseconds() int64 {
+	return d.end.Sub(d.start).Microseconds()
+}
+
+// Milliseconds returns the number of milliseconds in the duration (rounded down).
+func (d Duration) Milliseconds() int64 {
+	return d.end.Sub(d.start).Milliseconds()
+}
+
 // Seconds returns the number of seconds in the duration (rounded down).
 func (d Duration) Seconds() int64 {
 	return int64(d.end.Sub(d.start).Seconds())
@@ -50,7 +68,11 @@ func (d Duration) Hours() int64 {
 }
 
 // Days returns the number of days in the duration (rounded down).
-// This calculates calendar days, not 24-hour periods.
+// This calculates calendar days, not 24-hour periods: a day is complete when
+// the same wall clock time is reached on the following calendar day, so days
+// with a DST transition (23 or 25 hours) count as one full day.
+//
+// The calculation runs in constant time regardless of the span length.
 func (d Duration) Days() int {
 	start := d.start
 	end := d.end
@@ -62,12 +84,7 @@ func (d Duration) Days() int {
 		negative = true
 	}
 
-	// Calculate days by counting calendar days
-	days := 0
-	for start.Before(end) {
-		start = start.AddDate(0, 0, 1)
-		days++
-	}
+	days := calendarDays(start, end)
 
 	if negative {
 		return -days
@@ -75,6 +92,45 @@ func (d Duration) Days() int {
 	return days
 }
 
+// calendarDays returns the number of complete calendar days between start
+// and end (start must not be after end). Calendar dates are compared in the
+// location of start, matching the semantics of time.Time.AddDate.
+func calendarDays(start, end time.Time) int {
+	e := end.In(start.Location())
+	days := int(daysFromCivil(e.Year(), e.Month(), e.Day()) -
+		daysFromCivil(start.Year(), start.Month(), start.Day()))
+
+	// The last day is incomplete if end's time of day is before start's.
+	// AddDate only ever normalizes forward, so this runs at most a few times.
+	for days > 0 && start.AddDate(0, 0, days).After(end) {
+		days--
+	}
+
+	return days
+}
+
+// daysFromCivil returns the number of days since 1970-01-01 for the given
+// proleptic Gregorian calendar date. Based on Howard Hinnant's days_from_civil
+// algorithm; valid for all years representable by time.Time.
+func daysFromCivil(year int, month time.Month, day int) int64 {
+	y := int64(year)
+	if month <= time.February {
+		y--
+	}
+
+	// 400-year era, rounded towards negative infinity
+	era := y / 400
+	if y < 0 && y%400 != 0 {
+		era--
+	}
+
+	yoe := y - era*400                     // year of era [0, 399]
+	mp := (int64(month) + 9) % 12          // month starting in March [0, 11]
+	doy := (153*mp+2)/5 + int64(day) - 1   // day of year [0, 365]
+	doe := yoe*365 + yoe/4 - yoe/100 + doy // day of era [0, 146096]
+	return era*146097 + doe - 719468       // shift epoch to 1970-01-01
+}
+
 // Weeks returns the number of weeks in the duration (rounded down).
 func (d Duration) Weeks() int {
 	return d.Days() / 7
@@ -109,11 +165,27 @@ func (d Duration) Months() int {
 	return totalMonths
 }
 
+// HalfYears returns the number of half-years (6 months) in the duration
+// (rounded down).
+func (d Duration) HalfYears() int {
+	return d.Months() / 6
+}
+
 // Years returns the number of years in the duration (rounded down).
 func (d Duration) Years() int {
 	return d.Months() / 12
 }
 
+// Decades returns the number of decades in the duration (rounded down).
+func (d Duration) Decades() int {
+	return d.Months() / 120
+}
+
+// Centuries returns the number of centuries in the duration (rounded down).
+func (d Duration) Centuries() int {
+	return d.Months() / 1200
+}
+
 // MonthsFloat returns the precise number of months in the duration as a float64.
 // This provides more accurate calculations than the integer Months() method.
 func (d Duration) MonthsFloat() float64 {
@@ -282,3 +354,71 @@ func (d Duration) Human(lang ...Lang) string {
 
 	return result
 }
+
+// Period returns the duration broken down into years, months, days, hours,
+// minutes and seconds, such that adding the period to the start date yields
+// the end date:
+//
+//	quando.From(start).AddPeriod(quando.Diff(start, end).Period()) // equals end
+//
+// The components are chosen greedily in the order used by AddPeriod: as many
+// whole months as possible (with month-end clamping), then whole calendar
+// days, then the remaining elapsed time. Calendar dates are taken in the
+// location of the start time. Weeks are always zero; use Normalize to carry
+// days into weeks.
+//
+// For negative durations, all components are negative and are counted
+// backwards from the start, so the round trip holds as well. Because of
+// month-end clamping, the breakdown of a negative duration is not always the
+// negation of the breakdown with start and end swapped.
+//
+// Sub-second remainders are truncated; the round trip is exact when start and
+// end differ by whole seconds.
+//
+// Example:
+//
+//	start := time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC)
+//	end := time.Date(2027, 4, 3, 13, 30, 0, 0, time.UTC)
+//	p := quando.Diff(start, end).Period() // Period{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 30}
+//	p.String()                            // "P1Y2M3DT4H30M"
+func (d Duration) Period() Period {
+	start := d.start
+	end := d.end.In(start.Location())
+
+	sign := 1
+	if end.Before(start) {
+		sign = -1
+	}
+	// within reports whether t has not gone past end
+	within := func(t time.Time) bool {
+		if sign > 0 {
+			return !t.After(end)
+		}
+		return !t.Before(end)
+	}
+
+	// Start with the calendar month difference; clamping or an earlier time
+	// of day can make the last month incomplete.
+	months := sign * ((end.Year()-start.Year())*12 + int(end.Month()) - int(start.Month()))
+	for months > 0 && !within(addMonthsWithOverflow(start, sign*months)) {
+		months--
+	}
+	mid := addMonthsWithOverflow(start, sign*months)
+
+	days := sign * int(daysFromCivil(end.Year(), end.Month(), end.Day())-
+		daysFromCivil(mid.Year(), mid.Month(), mid.Day()))
+	for days > 0 && !within(mid.AddDate(0, 0, sign*days)) {
+		days--
+	}
+	mid = mid.AddDate(0, 0, sign*days)
+
+	rest := end.Sub(mid)
+	return Period{
+		Years:   sign * months / 12,
+		Months:  sign * months % 12,
+		Days:    sign * days,
+		Hours:   int(rest / time.Hour),
+		Minutes: int(rest % time.Hour / time.Minute),
+		Seconds: int(rest % time.Minute / time.Second),
+	}
+}
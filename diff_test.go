@@ -172,6 +172,48 @@ func TestDurationDays(t *testing.T) {
 			end:      time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
 			expected: -7,
 		},
+		{
+			name:     "partial day rounds down",
+			start:    time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+			end:      time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+			expected: 0,
+		},
+		{
+			name:     "end time of day before start time of day",
+			start:    time.Date(2026, 1, 1, 18, 0, 0, 0, time.UTC),
+			end:      time.Date(2026, 1, 8, 6, 0, 0, 0, time.UTC),
+			expected: 6,
+		},
+		{
+			name:     "end time of day after start time of day",
+			start:    time.Date(2026, 1, 1, 6, 0, 0, 0, time.UTC),
+			end:      time.Date(2026, 1, 8, 18, 0, 0, 0, time.UTC),
+			expected: 7,
+		},
+		{
+			name:     "negative partial day rounds towards zero",
+			start:    time.Date(2026, 1, 8, 6, 0, 0, 0, time.UTC),
+			end:      time.Date(2026, 1, 1, 18, 0, 0, 0, time.UTC),
+			expected: -6,
+		},
+		{
+			name:     "four centuries",
+			start:    time.Date(1800, 3, 1, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2200, 3, 1, 0, 0, 0, 0, time.UTC),
+			expected: 146097,
+		},
+		{
+			name:     "full time.Time range",
+			start:    time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC),
+			expected: 3652058,
+		},
+		{
+			name:     "different locations",
+			start:    time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2026, 1, 2, 1, 0, 0, 0, time.FixedZone("CET", 3600)),
+			expected: 1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -185,6 +227,98 @@ func TestDurationDays(t *testing.T) {
 	}
 }
 
+func TestDurationDaysDST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		expected int
+	}{
+		{
+			// 2026-03-29 has only 23 hours in Berlin
+			name:     "spring forward",
+			start:    time.Date(2026, 3, 28, 12, 0, 0, 0, berlin),
+			end:      time.Date(2026, 3, 30, 12, 0, 0, 0, berlin),
+			expected: 2,
+		},
+		{
+			// 2026-10-25 has 25 hours in Berlin
+			name:     "fall back",
+			start:    time.Date(2026, 10, 24, 12, 0, 0, 0, berlin),
+			end:      time.Date(2026, 10, 26, 12, 0, 0, 0, berlin),
+			expected: 2,
+		},
+		{
+			name:     "fall back, one hour short of the full day",
+			start:    time.Date(2026, 10, 24, 12, 0, 0, 0, berlin),
+			end:      time.Date(2026, 10, 25, 11, 0, 0, 0, berlin),
+			expected: 0,
+		},
+		{
+			name:     "whole year across both transitions",
+			start:    time.Date(2026, 1, 1, 0, 0, 0, 0, berlin),
+			end:      time.Date(2027, 1, 1, 0, 0, 0, 0, berlin),
+			expected: 365,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Diff(tt.start, tt.end).Days(); got != tt.expected {
+				t.Errorf("Days() = %d, want %d", got, tt.expected)
+			}
+			if got := Diff(tt.end, tt.start).Days(); got != -tt.expected {
+				t.Errorf("Days() reversed = %d, want %d", got, -tt.expected)
+			}
+		})
+	}
+}
+
+// TestDurationDaysMatchesAddDate verifies that Days agrees with stepping AddDate day by day
+func TestDurationDaysMatchesAddDate(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	start := time.Date(2026, 3, 1, 2, 30, 0, 0, berlin)
+	for h := 0; h < 24*70; h += 5 {
+		end := start.Add(time.Duration(h) * time.Hour)
+
+		expected := 0
+		for start.AddDate(0, 0, expected+1).Compare(end) <= 0 {
+			expected++
+		}
+
+		if got := Diff(start, end).Days(); got != expected {
+			t.Errorf("Days() from %v to %v = %d, want %d", start, end, got, expected)
+		}
+	}
+}
+
+func TestDaysFromCivil(t *testing.T) {
+	years := []int{-1000, -401, -400, -1, 0, 1, 1600, 1899, 1900, 1970, 2000, 2024, 2026, 2100, 9999}
+	for _, y := range years {
+		for m := time.January; m <= time.December; m++ {
+			for _, day := range []int{1, 15, 28} {
+				tm := time.Date(y, m, day, 0, 0, 0, 0, time.UTC)
+				expected := tm.Unix() / 86400
+				if tm.Unix() < 0 && tm.Unix()%86400 != 0 {
+					expected--
+				}
+				if got := daysFromCivil(y, m, day); got != expected {
+					t.Errorf("daysFromCivil(%d, %d, %d) = %d, want %d", y, m, day, got, expected)
+				}
+			}
+		}
+	}
+}
+
 func TestDurationWeeks(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -692,3 +826,132 @@ func TestDurationHumanAdaptiveGranularity(t *testing.T) {
 	}
 }
 
+func TestDurationExtendedUnits(t *testing.T) {
+	start := time.Date(2001, 3, 15, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2126, 9, 14, 0, 0, 0, 0, time.UTC)
+	d := Diff(start, end)
+
+	if got := d.HalfYears(); got != 250 {
+		t.Errorf("HalfYears() = %d, want 250", got)
+	}
+	if got := d.Decades(); got != 12 {
+		t.Errorf("Decades() = %d, want 12", got)
+	}
+	if got := d.Centuries(); got != 1 {
+		t.Errorf("Centuries() = %d, want 1", got)
+	}
+	if got := Diff(end, start).Decades(); got != -12 {
+		t.Errorf("negative Decades() = %d, want -12", got)
+	}
+
+	short := Diff(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 1, 0, 0, 1, 2345678, time.UTC))
+	if got := short.Milliseconds(); got != 1002 {
+		t.Errorf("Milliseconds() = %d, want 1002", got)
+	}
+	if got := short.Microseconds(); got != 1002345 {
+		t.Errorf("Microseconds() = %d, want 1002345", got)
+	}
+	if got := short.Nanoseconds(); got != 1002345678 {
+		t.Errorf("Nanoseconds() = %d, want 1002345678", got)
+	}
+}
+
+func TestDurationPeriod(t *testing.T) {
+	tests := []struct {
+		name  string
+		start time.Time
+		end   time.Time
+		want  Period
+	}{
+		{
+			"zero",
+			time.Date(2026, 2, 9, 12, 0, 0, 0, time.UTC),
+			time.Date(2026, 2, 9, 12, 0, 0, 0, time.UTC),
+			Period{},
+		},
+		{
+			"all components",
+			time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC),
+			time.Date(2027, 4, 3, 13, 30, 15, 0, time.UTC),
+			Period{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 30, Seconds: 15},
+		},
+		{
+			"clamped month counts as whole",
+			time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC),
+			Period{Months: 1},
+		},
+		{
+			"incomplete month",
+			time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC),
+			time.Date(2026, 2, 15, 11, 0, 0, 0, time.UTC),
+			Period{Days: 30, Hours: 23},
+		},
+		{
+			"incomplete day",
+			time.Date(2026, 2, 9, 18, 0, 0, 0, time.UTC),
+			time.Date(2026, 2, 11, 6, 0, 0, 0, time.UTC),
+			Period{Days: 1, Hours: 12},
+		},
+		{
+			"negative",
+			time.Date(2027, 4, 3, 13, 30, 15, 0, time.UTC),
+			time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC),
+			Period{Years: -1, Months: -2, Days: -3, Hours: -4, Minutes: -30, Seconds: -15},
+		},
+		{
+			"negative from month end",
+			time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC),
+			Period{Months: -1},
+		},
+		{
+			"sub-second remainder truncated",
+			time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, 2, 9, 0, 0, 1, 999999999, time.UTC),
+			Period{Seconds: 1},
+		},
+		{
+			"end in other location",
+			time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, 2, 10, 1, 0, 0, 0, time.FixedZone("CET", 3600)),
+			Period{Days: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Diff(tt.start, tt.end).Period()
+			if got != tt.want {
+				t.Errorf("Period() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDurationPeriod_RoundTrip(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data not available: %v", err)
+	}
+
+	dates := []time.Time{
+		time.Date(2024, 2, 29, 12, 0, 0, 0, loc),
+		time.Date(2025, 1, 31, 23, 30, 0, 0, loc),
+		time.Date(2026, 3, 28, 2, 30, 0, 0, loc),  // day before spring forward
+		time.Date(2026, 3, 29, 3, 30, 0, 0, loc),  // after spring forward
+		time.Date(2026, 10, 25, 2, 30, 0, 0, loc), // repeated hour
+		time.Date(2026, 12, 31, 0, 0, 0, 0, loc),
+		time.Date(2027, 2, 28, 18, 45, 10, 0, loc),
+		time.Date(2030, 5, 31, 6, 0, 0, 0, loc),
+	}
+
+	for _, start := range dates {
+		for _, end := range dates {
+			p := Diff(start, end).Period()
+			if got := From(start).AddPeriod(p).Time(); !got.Equal(end) {
+				t.Errorf("Diff(%v, %v).Period() = %v, AddPeriod gives %v", start, end, p, got)
+			}
+		}
+	}
+}
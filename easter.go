@@ -0,0 +1,75 @@
+package quando
+
+import "time"
+
+// Easter returns Western Easter Sunday of the given year at 00:00 UTC,
+// as observed by the Catholic and Protestant churches.
+//
+// The date is computed with the Gregorian computus (the anonymous
+// Meeus/Jones/Butcher algorithm) and is valid for any year of the Gregorian
+// calendar. For years before 1583 the result is in the proleptic Gregorian
+// calendar.
+//
+// Movable feasts are fixed offsets from Easter Sunday:
+//
+//	easter := quando.Easter(2026)           // 2026-04-05
+//	goodFriday := easter.Sub(2, quando.Days) // 2026-04-03
+//	easterMonday := easter.Add(1, quando.Days)
+//	ascension := easter.Add(39, quando.Days)
+//	pentecost := easter.Add(49, quando.Days)
+//	corpusChristi := easter.Add(60, quando.Days)
+func Easter(year int) Date {
+	return From(easterSunday(year))
+}
+
+// OrthodoxEaster returns Eastern Orthodox Easter Sunday (Pascha) of the given
+// year at 00:00 UTC.
+//
+// The date is computed with the Julian computus and converted to the Gregorian
+// calendar, so the result can be compared directly with other dates.
+// Movable feasts are offsets from it as with Easter.
+//
+// Example:
+//
+//	quando.OrthodoxEaster(2026) // 2026-04-12
+//	quando.Easter(2026)         // 2026-04-05
+func OrthodoxEaster(year int) Date {
+	return From(orthodoxEasterSunday(year))
+}
+
+// easterSunday returns Western Easter Sunday of the given year at 00:00 UTC,
+// using the anonymous Gregorian algorithm (Meeus/Jones/Butcher).
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// orthodoxEasterSunday returns Orthodox Easter Sunday of the given year at
+// 00:00 UTC (Gregorian calendar), using Meeus' Julian algorithm.
+func orthodoxEasterSunday(year int) time.Time {
+	a := year % 4
+	b := year % 7
+	c := year % 19
+	d := (19*c + 15) % 30
+	e := (2*a + 4*b - d + 34) % 7
+	month := (d + e + 114) / 31
+	day := (d+e+114)%31 + 1
+
+	// Difference between the Julian and Gregorian calendars in March-December
+	// of the year (13 days from 1900 to 2099)
+	shift := year/100 - year/400 - 2
+	return time.Date(year, time.Month(month), day+shift, 0, 0, 0, 0, time.UTC)
+}
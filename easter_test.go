@@ -0,0 +1,87 @@
+package quando
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEaster(t *testing.T) {
+	tests := []struct {
+		year     int
+		expected string
+	}{
+		{1583, "1583-04-10"},
+		{1818, "1818-03-22"},
+		{1943, "1943-04-25"},
+		{2000, "2000-04-23"},
+		{2019, "2019-04-21"},
+		{2024, "2024-03-31"},
+		{2025, "2025-04-20"},
+		{2026, "2026-04-05"},
+		{2038, "2038-04-25"},
+		{2285, "2285-03-22"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			got := Easter(tt.year)
+			if got.Format(ISO) != tt.expected {
+				t.Errorf("Easter(%d) = %v, want %v", tt.year, got.Format(ISO), tt.expected)
+			}
+			if got.Time().Weekday() != time.Sunday {
+				t.Errorf("Easter(%d) weekday = %v, want Sunday", tt.year, got.Time().Weekday())
+			}
+			if got.Time().Location() != time.UTC || got.Time().Hour() != 0 {
+				t.Errorf("Easter(%d) = %v, want 00:00 UTC", tt.year, got.Time())
+			}
+		})
+	}
+}
+
+func TestOrthodoxEaster(t *testing.T) {
+	tests := []struct {
+		year     int
+		expected string
+	}{
+		{1900, "1900-04-22"},
+		{2000, "2000-04-30"},
+		{2008, "2008-04-27"},
+		{2010, "2010-04-04"}, // same as Western Easter
+		{2021, "2021-05-02"},
+		{2023, "2023-04-16"},
+		{2024, "2024-05-05"},
+		{2025, "2025-04-20"}, // same as Western Easter
+		{2026, "2026-04-12"},
+		{2100, "2100-05-02"}, // Julian-Gregorian difference is 14 days
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			got := OrthodoxEaster(tt.year)
+			if got.Format(ISO) != tt.expected {
+				t.Errorf("OrthodoxEaster(%d) = %v, want %v", tt.year, got.Format(ISO), tt.expected)
+			}
+			if got.Time().Weekday() != time.Sunday {
+				t.Errorf("OrthodoxEaster(%d) weekday = %v, want Sunday", tt.year, got.Time().Weekday())
+			}
+		})
+	}
+}
+
+// TestEasterRange verifies that Easter always falls between March 22 and
+// April 25 and Orthodox Easter never precedes Western Easter
+func TestEasterRange(t *testing.T) {
+	for year := 1600; year <= 2400; year++ {
+		easter := easterSunday(year)
+		earliest := time.Date(year, time.March, 22, 0, 0, 0, 0, time.UTC)
+		latest := time.Date(year, time.April, 25, 0, 0, 0, 0, time.UTC)
+		if easter.Before(earliest) || easter.After(latest) {
+			t.Errorf("Easter(%d) = %v, want between March 22 and April 25", year, easter.Format("2006-01-02"))
+		}
+
+		if orthodox := orthodoxEasterSunday(year); orthodox.Before(easter) {
+			t.Errorf("OrthodoxEaster(%d) = %v, before Easter %v",
+				year, orthodox.Format("2006-01-02"), easter.Format("2006-01-02"))
+		}
+	}
+}
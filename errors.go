@@ -61,19 +61,20 @@ var ErrInvalidTimezone = errors.New("invalid timezone")
 // ErrOverflow indicates that a date arithmetic operation would result in
 // a date outside the representable range.
 //
-// This error is returned when:
-//   - Adding/subtracting would exceed Go's time.Time range (approximately year 0 to 9999)
-//   - The resulting date would be before the minimum or after the maximum representable time
+// This error is returned by the checked arithmetic methods TryAdd and TrySub
+// when:
+//   - Converting the value to the unit would overflow (e.g. value*12 months
+//     for Years, or value*time.Hour for Hours)
+//   - The resulting date would lie outside the years 1 to 9999
+//
+// Add and Sub do not check for overflow; use TryAdd and TrySub for values
+// that are not known to be in range, such as user input.
 //
 // Example:
 //
 //	date := quando.From(time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC))
-//	result, err := date.Add(1, quando.Years)
+//	result, err := date.TryAdd(1, quando.Years)
 //	if errors.Is(err, quando.ErrOverflow) {
 //	    log.Printf("Date overflow: %v", err)
 //	}
-//
-// Note: In the current implementation, arithmetic operations that would overflow
-// are handled by Go's time.Time, which has its own overflow behavior. This error
-// is reserved for future use when explicit overflow detection is added.
 var ErrOverflow = errors.New("date overflow")
@@ -1,8 +1,10 @@
 package quando_test
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"code.beautifulmachines.dev/jakoubek/quando"
@@ -952,3 +954,452 @@ func ExampleMustParse_staticInit() {
 	// Epoch: 1970
 	// Y2K: 2000
 }
+
+// ExampleDate_MarshalJSON demonstrates embedding a Date in a JSON document
+func ExampleDate_MarshalJSON() {
+	type Invoice struct {
+		Number string      `json:"number"`
+		Due    quando.Date `json:"due"`
+	}
+
+	invoice := Invoice{
+		Number: "2026-001",
+		Due:    quando.From(time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC)),
+	}
+
+	b, _ := json.Marshal(invoice)
+	fmt.Println(string(b))
+	// Output: {"number":"2026-001","due":"2026-02-09T00:00:00Z"}
+}
+
+// ExampleDate_UnmarshalJSON demonstrates that decoding accepts every format Parse detects
+func ExampleDate_UnmarshalJSON() {
+	var due quando.Date
+	if err := json.Unmarshal([]byte(`"09.02.2026"`), &due); err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println(due)
+	// Output: 2026-02-09 00:00:00
+}
+
+// ExampleDate_Compare demonstrates sorting dates with slices.SortFunc
+func ExampleDate_Compare() {
+	dates := []quando.Date{
+		quando.MustParse("2026-03-01"),
+		quando.MustParse("2025-12-31"),
+		quando.MustParse("2026-02-09"),
+	}
+
+	slices.SortFunc(dates, quando.Date.Compare)
+
+	for _, d := range dates {
+		fmt.Println(d.Format(quando.ISO))
+	}
+	// Output:
+	// 2025-12-31
+	// 2026-02-09
+	// 2026-03-01
+}
+
+// ExampleDate_IsSame demonstrates checking whether two dates share a unit
+func ExampleDate_IsSame() {
+	monday := quando.From(time.Date(2026, 2, 9, 8, 0, 0, 0, time.UTC))
+	sunday := quando.From(time.Date(2026, 2, 15, 20, 0, 0, 0, time.UTC))
+
+	fmt.Printf("Same day: %v\n", monday.IsSame(sunday, quando.Days))
+	fmt.Printf("Same week: %v\n", monday.IsSame(sunday, quando.Weeks))
+	fmt.Printf("Same month: %v\n", monday.IsSame(sunday, quando.Months))
+	// Output:
+	// Same day: false
+	// Same week: true
+	// Same month: true
+}
+
+// ExampleMin demonstrates finding the earliest and latest of several dates
+func ExampleMin() {
+	a := quando.MustParse("2026-02-09")
+	b := quando.MustParse("2026-01-01")
+	c := quando.MustParse("2026-12-31")
+
+	fmt.Println(quando.Min(a, b, c).Format(quando.ISO))
+	fmt.Println(quando.Max(a, b, c).Format(quando.ISO))
+	// Output:
+	// 2026-01-01
+	// 2026-12-31
+}
+
+// ExampleDate_AddBusinessDays demonstrates computing a "net 10 business days" due date
+func ExampleDate_AddBusinessDays() {
+	issued := quando.MustParse("2026-02-13") // Friday
+	due := issued.AddBusinessDays(10)
+	fmt.Println(due.Format(quando.ISO), due.Time().Weekday())
+	// Output: 2026-02-27 Friday
+}
+
+// ExampleHolidayCalendar demonstrates plugging custom holidays into business-day arithmetic
+func ExampleHolidayCalendar() {
+	companyHolidays := quando.NewHolidayList(
+		quando.MustParse("2026-12-24"),
+		quando.MustParse("2026-12-25"),
+		quando.MustParse("2026-12-31"),
+	)
+
+	date := quando.MustParse("2026-12-23") // Wednesday
+	fmt.Println(date.NextBusinessDay(companyHolidays).Format(quando.ISO))
+	fmt.Println(date.IsBusinessDay(companyHolidays))
+	// Output:
+	// 2026-12-28
+	// true
+}
+
+// ExampleDuration_BusinessDays demonstrates counting business days between two dates
+func ExampleDuration_BusinessDays() {
+	start := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)
+	fmt.Println(quando.Diff(start, end).BusinessDays())
+	// Output: 20
+}
+
+// ExampleEaster demonstrates computing Easter and movable feasts
+func ExampleEaster() {
+	easter := quando.Easter(2026)
+	fmt.Println("Easter:       ", easter.Format(quando.ISO))
+	fmt.Println("Good Friday:  ", easter.Sub(2, quando.Days).Format(quando.ISO))
+	fmt.Println("Ascension:    ", easter.Add(39, quando.Days).Format(quando.ISO))
+	fmt.Println("Pentecost:    ", easter.Add(49, quando.Days).Format(quando.ISO))
+	fmt.Println("Orthodox:     ", quando.OrthodoxEaster(2026).Format(quando.ISO))
+	// Output:
+	// Easter:        2026-04-05
+	// Good Friday:   2026-04-03
+	// Ascension:     2026-05-14
+	// Pentecost:     2026-05-24
+	// Orthodox:      2026-04-12
+}
+
+// ExampleNewGermanHolidays demonstrates state-specific German public holidays
+func ExampleNewGermanHolidays() {
+	federal := quando.NewGermanHolidays()
+	bavaria := quando.NewGermanHolidays(quando.DEBY)
+
+	corpusChristi := quando.MustParse("2026-06-04")
+	fmt.Printf("Federal: %v\n", corpusChristi.IsHoliday(federal))
+	fmt.Printf("Bavaria: %v\n", corpusChristi.IsHoliday(bavaria))
+	// Output:
+	// Federal: false
+	// Bavaria: true
+}
+
+// ExampleGermanHolidays_Holidays demonstrates listing the holidays of a year
+func ExampleGermanHolidays_Holidays() {
+	for _, h := range quando.NewGermanHolidays(quando.DESN).Holidays(2026) {
+		fmt.Println(h.Date.Format(quando.ISO), h.Name)
+	}
+	// Output:
+	// 2026-01-01 Neujahr
+	// 2026-04-03 Karfreitag
+	// 2026-04-06 Ostermontag
+	// 2026-05-01 Tag der Arbeit
+	// 2026-05-14 Christi Himmelfahrt
+	// 2026-05-25 Pfingstmontag
+	// 2026-10-03 Tag der Deutschen Einheit
+	// 2026-10-31 Reformationstag
+	// 2026-11-18 Buß- und Bettag
+	// 2026-12-25 1. Weihnachtstag
+	// 2026-12-26 2. Weihnachtstag
+}
+
+// ExampleNewUSFederalHolidays demonstrates observed US federal holidays
+func ExampleNewUSFederalHolidays() {
+	us := quando.NewUSFederalHolidays()
+
+	// July 4, 2026 is a Saturday and observed on Friday, July 3
+	fmt.Printf("July 3: %v\n", quando.MustParse("2026-07-03").IsHoliday(us))
+	fmt.Printf("July 4: %v\n", quando.MustParse("2026-07-04").IsHoliday(us))
+
+	// Wednesday before Thanksgiving
+	next := quando.MustParse("2026-11-25").NextBusinessDay(us)
+	fmt.Println(next.Format(quando.ISO))
+	// Output:
+	// July 3: true
+	// July 4: false
+	// 2026-11-27
+}
+
+// ExampleRange_Each demonstrates iterating over a range month by month
+func ExampleRange_Each() {
+	r := quando.NewRange(
+		quando.MustParse("2026-01-31"),
+		quando.MustParse("2026-05-01"),
+	)
+	for d := range r.Each(quando.Months) {
+		fmt.Println(d.Format(quando.ISO))
+	}
+	// Output:
+	// 2026-01-31
+	// 2026-02-28
+	// 2026-03-31
+	// 2026-04-30
+}
+
+// ExampleNewInclusiveRange demonstrates an inclusive range
+func ExampleNewInclusiveRange() {
+	vacation := quando.NewInclusiveRange(
+		quando.MustParse("2026-07-06"),
+		quando.MustParse("2026-07-10"),
+	)
+	fmt.Println(vacation.Contains(quando.MustParse("2026-07-10")))
+	fmt.Println(vacation)
+	// Output:
+	// true
+	// [2026-07-06 00:00:00, 2026-07-10 00:00:00]
+}
+
+// ExampleRange_Gaps demonstrates finding free periods between bookings
+func ExampleRange_Gaps() {
+	july := quando.NewRange(quando.MustParse("2026-07-01"), quando.MustParse("2026-08-01"))
+	bookings := []quando.Range{
+		quando.NewRange(quando.MustParse("2026-07-20"), quando.MustParse("2026-07-25")),
+		quando.NewRange(quando.MustParse("2026-07-05"), quando.MustParse("2026-07-10")),
+		quando.NewRange(quando.MustParse("2026-07-08"), quando.MustParse("2026-07-12")),
+	}
+
+	for _, free := range july.Gaps(bookings...) {
+		fmt.Println(free.Start().Format(quando.ISO), "to", free.End().Format(quando.ISO))
+	}
+	// Output:
+	// 2026-07-01 to 2026-07-05
+	// 2026-07-12 to 2026-07-20
+	// 2026-07-25 to 2026-08-01
+}
+
+// ExampleRange_Intersect demonstrates intersecting two ranges
+func ExampleRange_Intersect() {
+	a := quando.NewRange(quando.MustParse("2026-07-01"), quando.MustParse("2026-07-08"))
+	b := quando.NewRange(quando.MustParse("2026-07-05"), quando.MustParse("2026-07-12"))
+
+	both, ok := a.Intersect(b)
+	fmt.Println(both, ok)
+	// Output:
+	// [2026-07-05 00:00:00, 2026-07-08 00:00:00) true
+}
+
+// ExampleRRule_Occurrences demonstrates generating recurring dates
+func ExampleRRule_Occurrences() {
+	// Last weekday of each month
+	rule := quando.MustParseRRule("FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=3")
+	for d := range rule.Occurrences(quando.MustParse("2026-01-01 09:00:00")) {
+		fmt.Println(d)
+	}
+	// Output:
+	// 2026-01-30 09:00:00
+	// 2026-02-27 09:00:00
+	// 2026-03-31 09:00:00
+}
+
+// ExampleParseRRule demonstrates month-end handling of yearly rules
+func ExampleParseRRule() {
+	rule, err := quando.ParseRRule("RRULE:FREQ=YEARLY;COUNT=3")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for d := range rule.Occurrences(quando.MustParse("2024-02-29")) {
+		fmt.Println(d.Format(quando.ISO))
+	}
+	fmt.Println(rule)
+	// Output:
+	// 2024-02-29
+	// 2025-02-28
+	// 2026-02-28
+	// FREQ=YEARLY;COUNT=3
+}
+
+// ExampleCron_Next demonstrates computing the next fire times of a cron expression
+func ExampleCron_Next() {
+	schedule := quando.MustParseCron("30 8 * * MON-FRI")
+
+	friday := quando.MustParse("2026-02-13 09:00:00")
+	fmt.Println(schedule.Next(friday))
+	fmt.Println(schedule.Prev(friday))
+	// Output:
+	// 2026-02-16 08:30:00
+	// 2026-02-13 08:30:00
+}
+
+// ExampleCron_Upcoming demonstrates iterating fire times with a fixed clock
+func ExampleCron_Upcoming() {
+	clock := quando.NewFixedClock(time.Date(2026, 2, 9, 12, 0, 0, 0, time.UTC))
+	schedule := quando.MustParseCron("@weekly")
+
+	count := 0
+	for next := range schedule.Upcoming(clock) {
+		fmt.Println(next)
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	// Output:
+	// 2026-02-15 00:00:00
+	// 2026-02-22 00:00:00
+}
+
+// ExampleDate_WithWeekStart demonstrates Sunday-start weeks as used in the US
+func ExampleDate_WithWeekStart() {
+	date := quando.From(time.Date(2026, 1, 4, 12, 0, 0, 0, time.UTC)) // Sunday
+
+	iso := date
+	us := date.WithWeekStart(quando.WeekStartFor("en-US"))
+
+	fmt.Println(iso.StartOf(quando.Weeks), iso.WeekOfYear())
+	fmt.Println(us.StartOf(quando.Weeks), us.WeekOfYear())
+	// Output:
+	// 2025-12-29 00:00:00 1
+	// 2026-01-04 00:00:00 2
+}
+
+// ExampleDate_WithWeekend demonstrates business days with a Friday/Saturday weekend
+func ExampleDate_WithWeekend() {
+	thursday := quando.MustParse("2026-02-12").WithWeekend(quando.WeekendFor("he-IL"))
+
+	fmt.Println(thursday.Add(1, quando.Days).IsWeekend())
+	fmt.Println(thursday.NextBusinessDay())
+	// Output:
+	// true
+	// 2026-02-15 00:00:00
+}
+
+// ExampleDate_FiscalLabel demonstrates a fiscal year starting in October
+func ExampleDate_FiscalLabel() {
+	fy := quando.FiscalCalendar{StartMonth: time.October}
+	date := quando.MustParse("2026-11-15")
+
+	fmt.Println(date.FiscalLabel(fy))
+	fmt.Println(date.StartOfFiscal(fy, quando.Years))
+	fmt.Println(date.EndOfFiscal(fy, quando.Quarters))
+	// Output:
+	// FY2027 Q1
+	// 2026-10-01 00:00:00
+	// 2026-12-31 23:59:59
+}
+
+// ExampleDate_Retail demonstrates the NRF 4-5-4 retail calendar
+func ExampleDate_Retail() {
+	nrf := quando.NewNRFCalendar()
+	date := quando.MustParse("2024-01-10")
+
+	r := date.Retail(nrf)
+	fmt.Printf("FY%d P%d W%d\n", r.Year, r.Period, r.Week)
+	fmt.Println(nrf.WeeksInYear(r.Year))
+	fmt.Println(date.StartOfRetail(nrf, quando.Years))
+	fmt.Println(date.EndOfRetail(nrf, quando.Years))
+	// Output:
+	// FY2023 P12 W50
+	// 53
+	// 2023-01-29 00:00:00
+	// 2024-02-03 23:59:59
+}
+
+// ExampleDate_NthWeekdayOf demonstrates finding the nth or last weekday of a period
+func ExampleDate_NthWeekdayOf() {
+	date := quando.MustParse("2026-11-10")
+
+	expiry, _ := date.NthWeekdayOf(3, time.Friday, quando.Months)
+	thanksgiving, _ := date.NthWeekdayOf(-1, time.Thursday, quando.Months)
+	_, ok := date.NthWeekdayOf(5, time.Friday, quando.Months)
+
+	fmt.Println(expiry)
+	fmt.Println(thanksgiving)
+	fmt.Println(ok)
+	// Output:
+	// 2026-11-20 00:00:00
+	// 2026-11-26 00:00:00
+	// false
+}
+
+// ExampleDate_NextOrSame demonstrates inclusive and counted weekday navigation
+func ExampleDate_NextOrSame() {
+	friday := quando.MustParse("2026-02-13 15:30:00")
+
+	fmt.Println(friday.NextOrSame(time.Friday))
+	fmt.Println(friday.Closest(time.Monday))
+	fmt.Println(friday.NextN(3, time.Monday))
+	// Output:
+	// 2026-02-13 15:30:00
+	// 2026-02-16 15:30:00
+	// 2026-03-02 15:30:00
+}
+
+// ExampleDate_TryAdd demonstrates overflow detection in date arithmetic
+func ExampleDate_TryAdd() {
+	date := quando.MustParse("9999-06-01")
+
+	next, err := date.TryAdd(6, quando.Months)
+	fmt.Println(next, err)
+
+	_, err = date.TryAdd(1, quando.Years)
+	fmt.Println(errors.Is(err, quando.ErrOverflow))
+	fmt.Println(err)
+	// Output:
+	// 9999-12-01 00:00:00 <nil>
+	// true
+	// adding 1 years to 9999-06-01 00:00:00: result year 10000 outside 1-9999: date overflow
+}
+
+// ExampleDate_WithMonthEndPolicy demonstrates the month-end policies of Add
+func ExampleDate_WithMonthEndPolicy() {
+	jan31 := quando.MustParse("2026-01-31")
+	feb28 := quando.MustParse("2026-02-28")
+
+	fmt.Println(jan31.Add(1, quando.Months))
+	fmt.Println(jan31.WithMonthEndPolicy(quando.MonthEndOverflow).Add(1, quando.Months))
+	fmt.Println(feb28.WithMonthEndPolicy(quando.MonthEndSticky).Add(1, quando.Months))
+	// Output:
+	// 2026-02-28 00:00:00
+	// 2026-03-03 00:00:00
+	// 2026-03-31 00:00:00
+}
+
+// ExampleDate_AddPeriod demonstrates adding an ISO 8601 duration
+func ExampleDate_AddPeriod() {
+	p := quando.MustParsePeriod("P1M1DT12H")
+	date := quando.MustParse("2026-01-31")
+
+	fmt.Println(date.AddPeriod(p))
+	fmt.Println(date.SubPeriod(p))
+	fmt.Println(quando.Period{Months: 14, Minutes: 90}.Normalize())
+	// Output:
+	// 2026-03-01 12:00:00
+	// 2025-12-29 12:00:00
+	// P1Y2MT1H30M
+}
+
+// ExampleDuration_Period demonstrates breaking a duration into calendar components
+func ExampleDuration_Period() {
+	start := time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC)
+	end := time.Date(2027, 4, 3, 13, 30, 0, 0, time.UTC)
+
+	p := quando.Diff(start, end).Period()
+	fmt.Println(p)
+	fmt.Println(quando.From(start).AddPeriod(p).Time().Equal(end))
+	// Output:
+	// P1Y2M3DT4H30M
+	// true
+}
+
+// ExampleDate_AddWallClock demonstrates wall-clock and elapsed-time arithmetic across DST
+func ExampleDate_AddWallClock() {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	// Clocks spring forward on March 29, 2026
+	date := quando.From(time.Date(2026, 3, 28, 12, 0, 0, 0, berlin))
+
+	fmt.Println(date.Add(24, quando.Hours))
+	fmt.Println(date.AddWallClock(24, quando.Hours))
+	fmt.Println(date.AddAbsolute(1, quando.Days))
+	// Output:
+	// 2026-03-29 13:00:00
+	// 2026-03-29 12:00:00
+	// 2026-03-29 13:00:00
+}
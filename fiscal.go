@@ -0,0 +1,160 @@
+package quando
+
+import (
+	"fmt"
+	"time"
+)
+
+// FiscalYearNaming determines which calendar year a fiscal year is named
+// after when it spans two calendar years.
+type FiscalYearNaming int
+
+const (
+	// NameByEndYear names the fiscal year after the calendar year in which it
+	// ends (default). With an October start, Oct 2026 - Sep 2027 is FY2027,
+	// as used by the US federal government.
+	NameByEndYear FiscalYearNaming = iota
+
+	// NameByStartYear names the fiscal year after the calendar year in which
+	// it starts. With an April start, Apr 2026 - Mar 2027 is FY2026.
+	NameByStartYear
+)
+
+// FiscalCalendar describes a fiscal year that starts on the first day of
+// StartMonth and is divided into four quarters of three months each.
+//
+// The zero value is a fiscal year equal to the calendar year. A StartMonth
+// outside 1-12 is treated as January.
+//
+// Example:
+//
+//	fy := quando.FiscalCalendar{StartMonth: time.October}
+//	date := quando.MustParse("2026-11-15")
+//	date.FiscalYear(fy)    // 2027
+//	date.FiscalQuarter(fy) // 1
+//	date.FiscalLabel(fy)   // "FY2027 Q1"
+type FiscalCalendar struct {
+	StartMonth time.Month       // First month of the fiscal year
+	Naming     FiscalYearNaming // Calendar year the fiscal year is named after
+}
+
+// startMonth returns the first month of the fiscal year, defaulting to January.
+func (fc FiscalCalendar) startMonth() time.Month {
+	if fc.StartMonth < time.January || fc.StartMonth > time.December {
+		return time.January
+	}
+	return fc.StartMonth
+}
+
+// fiscalPosition returns the calendar year in which the fiscal year of t
+// starts and the number of months between the fiscal year start and t (0-11).
+func (fc FiscalCalendar) fiscalPosition(t time.Time) (startYear, month int) {
+	start := fc.startMonth()
+	startYear = t.Year()
+	if t.Month() < start {
+		startYear--
+	}
+	return startYear, (int(t.Month()) - int(start) + 12) % 12
+}
+
+// FiscalYear returns the fiscal year the date belongs to, named according to
+// the calendar's naming convention.
+//
+// Example:
+//
+//	date := quando.MustParse("2026-05-10")
+//	date.FiscalYear(quando.FiscalCalendar{StartMonth: time.April})                                // 2027
+//	date.FiscalYear(quando.FiscalCalendar{StartMonth: time.April, Naming: quando.NameByStartYear}) // 2026
+func (d Date) FiscalYear(fc FiscalCalendar) int {
+	startYear, _ := fc.fiscalPosition(d.t)
+	if fc.Naming == NameByEndYear && fc.startMonth() != time.January {
+		return startYear + 1
+	}
+	return startYear
+}
+
+// FiscalQuarter returns the fiscal quarter (1-4) the date belongs to.
+// Quarter 1 starts with the calendar's StartMonth.
+//
+// Example:
+//
+//	date := quando.MustParse("2026-05-10")
+//	q := date.FiscalQuarter(quando.FiscalCalendar{StartMonth: time.April}) // 1
+func (d Date) FiscalQuarter(fc FiscalCalendar) int {
+	_, month := fc.fiscalPosition(d.t)
+	return month/3 + 1
+}
+
+// FiscalLabel returns a label for the fiscal quarter of the date in the form
+// "FY2027 Q1".
+//
+// Example:
+//
+//	date := quando.MustParse("2026-11-15")
+//	label := date.FiscalLabel(quando.FiscalCalendar{StartMonth: time.October}) // "FY2027 Q1"
+func (d Date) FiscalLabel(fc FiscalCalendar) string {
+	return fmt.Sprintf("FY%d Q%d", d.FiscalYear(fc), d.FiscalQuarter(fc))
+}
+
+// StartOfFiscal returns a new Date snapped to the beginning of the fiscal
+// quarter or fiscal year, at 00:00:00 in the date's location.
+//
+// Supported units:
+//   - Quarters: first day of the fiscal quarter
+//   - Years: first day of the fiscal year
+//
+// Other units return the zero Date (see IsZero).
+//
+// Example:
+//
+//	fy := quando.FiscalCalendar{StartMonth: time.April}
+//	date := quando.MustParse("2026-02-09")
+//	date.StartOfFiscal(fy, quando.Quarters) // Jan 1, 2026 00:00:00 (Q4)
+//	date.StartOfFiscal(fy, quando.Years)    // Apr 1, 2025 00:00:00
+func (d Date) StartOfFiscal(fc FiscalCalendar, unit Unit) Date {
+	startYear, month := fc.fiscalPosition(d.t)
+	start := int(fc.startMonth())
+
+	switch unit {
+	case Quarters:
+		result := time.Date(startYear, time.Month(start+month/3*3), 1, 0, 0, 0, 0, d.t.Location())
+		return d.with(result)
+	case Years:
+		result := time.Date(startYear, time.Month(start), 1, 0, 0, 0, 0, d.t.Location())
+		return d.with(result)
+	default:
+		return Date{}
+	}
+}
+
+// EndOfFiscal returns a new Date snapped to the end of the fiscal quarter or
+// fiscal year, at 23:59:59.999999999 in the date's location.
+//
+// Supported units:
+//   - Quarters: last day of the fiscal quarter
+//   - Years: last day of the fiscal year
+//
+// Other units return the zero Date (see IsZero).
+//
+// Example:
+//
+//	fy := quando.FiscalCalendar{StartMonth: time.April}
+//	date := quando.MustParse("2026-02-09")
+//	date.EndOfFiscal(fy, quando.Quarters) // Mar 31, 2026 23:59:59 (Q4)
+//	date.EndOfFiscal(fy, quando.Years)    // Mar 31, 2026 23:59:59
+func (d Date) EndOfFiscal(fc FiscalCalendar, unit Unit) Date {
+	startYear, month := fc.fiscalPosition(d.t)
+	start := int(fc.startMonth())
+
+	// Day 0 of a month is the last day of the previous month
+	switch unit {
+	case Quarters:
+		result := time.Date(startYear, time.Month(start+month/3*3+3), 0, 23, 59, 59, 999999999, d.t.Location())
+		return d.with(result)
+	case Years:
+		result := time.Date(startYear, time.Month(start+12), 0, 23, 59, 59, 999999999, d.t.Location())
+		return d.with(result)
+	default:
+		return Date{}
+	}
+}
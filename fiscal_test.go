@@ -0,0 +1,128 @@
+package quando
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFiscalYearAndQuarter(t *testing.T) {
+	april := FiscalCalendar{StartMonth: time.April}
+	aprilByStart := FiscalCalendar{StartMonth: time.April, Naming: NameByStartYear}
+	october := FiscalCalendar{StartMonth: time.October}
+
+	tests := []struct {
+		name        string
+		date        string
+		fc          FiscalCalendar
+		wantYear    int
+		wantQuarter int
+		wantLabel   string
+	}{
+		{"zero value is calendar year", "2026-02-09", FiscalCalendar{}, 2026, 1, "FY2026 Q1"},
+		{"zero value Q4", "2026-12-31", FiscalCalendar{}, 2026, 4, "FY2026 Q4"},
+		{"January start by start year", "2026-07-01", FiscalCalendar{StartMonth: time.January, Naming: NameByStartYear}, 2026, 3, "FY2026 Q3"},
+		{"invalid month means January", "2026-05-01", FiscalCalendar{StartMonth: 13}, 2026, 2, "FY2026 Q2"},
+
+		{"April first day", "2026-04-01", april, 2027, 1, "FY2027 Q1"},
+		{"April Q2", "2026-08-15", april, 2027, 2, "FY2027 Q2"},
+		{"April Q3", "2026-12-31", april, 2027, 3, "FY2027 Q3"},
+		{"April Q4", "2027-01-01", april, 2027, 4, "FY2027 Q4"},
+		{"April last day", "2026-03-31", april, 2026, 4, "FY2026 Q4"},
+
+		{"April by start year", "2026-04-01", aprilByStart, 2026, 1, "FY2026 Q1"},
+		{"April by start year Q4", "2027-03-31", aprilByStart, 2026, 4, "FY2026 Q4"},
+
+		{"October first day", "2026-10-01", october, 2027, 1, "FY2027 Q1"},
+		{"October Q1", "2026-11-15", october, 2027, 1, "FY2027 Q1"},
+		{"October Q2", "2027-02-28", october, 2027, 2, "FY2027 Q2"},
+		{"October last day", "2026-09-30", october, 2026, 4, "FY2026 Q4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date := MustParse(tt.date)
+			if got := date.FiscalYear(tt.fc); got != tt.wantYear {
+				t.Errorf("FiscalYear() = %d, want %d", got, tt.wantYear)
+			}
+			if got := date.FiscalQuarter(tt.fc); got != tt.wantQuarter {
+				t.Errorf("FiscalQuarter() = %d, want %d", got, tt.wantQuarter)
+			}
+			if got := date.FiscalLabel(tt.fc); got != tt.wantLabel {
+				t.Errorf("FiscalLabel() = %q, want %q", got, tt.wantLabel)
+			}
+		})
+	}
+}
+
+func TestFiscalQuarterMatchesQuarterForCalendarYear(t *testing.T) {
+	for month := time.January; month <= time.December; month++ {
+		date := From(time.Date(2026, month, 15, 0, 0, 0, 0, time.UTC))
+		if got, want := date.FiscalQuarter(FiscalCalendar{}), date.Quarter(); got != want {
+			t.Errorf("%s: FiscalQuarter() = %d, Quarter() = %d", month, got, want)
+		}
+	}
+}
+
+func TestStartEndOfFiscal(t *testing.T) {
+	april := FiscalCalendar{StartMonth: time.April}
+	october := FiscalCalendar{StartMonth: time.October}
+
+	tests := []struct {
+		name  string
+		date  string
+		fc    FiscalCalendar
+		unit  Unit
+		start string
+		end   string
+	}{
+		{"April quarter Q4", "2026-02-09 15:30:00", april, Quarters, "2026-01-01 00:00:00", "2026-03-31 23:59:59"},
+		{"April quarter Q1", "2026-05-20 08:00:00", april, Quarters, "2026-04-01 00:00:00", "2026-06-30 23:59:59"},
+		{"April year before start", "2026-02-09 15:30:00", april, Years, "2025-04-01 00:00:00", "2026-03-31 23:59:59"},
+		{"April year after start", "2026-04-01 00:00:00", april, Years, "2026-04-01 00:00:00", "2027-03-31 23:59:59"},
+		{"October quarter spanning year end", "2026-12-24 12:00:00", october, Quarters, "2026-10-01 00:00:00", "2026-12-31 23:59:59"},
+		{"October Q2 with leap February", "2028-02-10 12:00:00", october, Quarters, "2028-01-01 00:00:00", "2028-03-31 23:59:59"},
+		{"October year", "2026-12-24 12:00:00", october, Years, "2026-10-01 00:00:00", "2027-09-30 23:59:59"},
+		{"calendar year", "2026-07-04 12:00:00", FiscalCalendar{}, Years, "2026-01-01 00:00:00", "2026-12-31 23:59:59"},
+		{"unsupported unit", "2026-07-04 12:00:00", april, Months, "0001-01-01 00:00:00", "0001-01-01 00:00:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date := MustParse(tt.date)
+			if got := date.StartOfFiscal(tt.fc, tt.unit).String(); got != tt.start {
+				t.Errorf("StartOfFiscal() = %s, want %s", got, tt.start)
+			}
+			if got := date.EndOfFiscal(tt.fc, tt.unit).String(); got != tt.end {
+				t.Errorf("EndOfFiscal() = %s, want %s", got, tt.end)
+			}
+		})
+	}
+}
+
+func TestStartEndOfFiscalMatchesCalendarQuarters(t *testing.T) {
+	for month := time.January; month <= time.December; month++ {
+		date := From(time.Date(2026, month, 15, 10, 0, 0, 0, time.UTC))
+		if got, want := date.StartOfFiscal(FiscalCalendar{}, Quarters), date.StartOf(Quarters); !got.Time().Equal(want.Time()) {
+			t.Errorf("%s: StartOfFiscal() = %v, StartOf() = %v", month, got, want)
+		}
+		if got, want := date.EndOfFiscal(FiscalCalendar{}, Quarters), date.EndOf(Quarters); !got.Time().Equal(want.Time()) {
+			t.Errorf("%s: EndOfFiscal() = %v, EndOf() = %v", month, got, want)
+		}
+	}
+}
+
+func TestStartOfFiscalTimezone(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data not available: %v", err)
+	}
+
+	date := From(time.Date(2026, 5, 20, 12, 0, 0, 0, loc))
+	start := date.StartOfFiscal(FiscalCalendar{StartMonth: time.April}, Years).Time()
+	if want := time.Date(2026, 4, 1, 0, 0, 0, 0, loc); !start.Equal(want) {
+		t.Errorf("StartOfFiscal() = %v, want %v", start, want)
+	}
+	if start.Location() != loc {
+		t.Errorf("StartOfFiscal() location = %v, want %v", start.Location(), loc)
+	}
+}
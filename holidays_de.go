@@ -0,0 +1,186 @@
+package quando
+
+import (
+	"slices"
+	"time"
+)
+
+// GermanState identifies a German federal state (Bundesland) by its
+// ISO 3166-2 code. Use it with NewGermanHolidays to include the state's
+// public holidays in addition to the nationwide ones.
+type GermanState string
+
+// German federal states (Bundesländer).
+const (
+	// DEBW represents Baden-Württemberg.
+	DEBW GermanState = "DE-BW"
+	// DEBY represents Bayern (Bavaria).
+	DEBY GermanState = "DE-BY"
+	// DEBE represents Berlin.
+	DEBE GermanState = "DE-BE"
+	// DEBB represents Brandenburg.
+	DEBB GermanState = "DE-BB"
+	// DEHB represents Bremen.
+	DEHB GermanState = "DE-HB"
+	// DEHH represents Hamburg.
+	DEHH GermanState = "DE-HH"
+	// DEHE represents Hessen (Hesse).
+	DEHE GermanState = "DE-HE"
+	// DEMV represents Mecklenburg-Vorpommern.
+	DEMV GermanState = "DE-MV"
+	// DENI represents Niedersachsen (Lower Saxony).
+	DENI GermanState = "DE-NI"
+	// DENW represents Nordrhein-Westfalen (North Rhine-Westphalia).
+	DENW GermanState = "DE-NW"
+	// DERP represents Rheinland-Pfalz (Rhineland-Palatinate).
+	DERP GermanState = "DE-RP"
+	// DESL represents Saarland.
+	DESL GermanState = "DE-SL"
+	// DESN represents Sachsen (Saxony).
+	DESN GermanState = "DE-SN"
+	// DEST represents Sachsen-Anhalt (Saxony-Anhalt).
+	DEST GermanState = "DE-ST"
+	// DESH represents Schleswig-Holstein.
+	DESH GermanState = "DE-SH"
+	// DETH represents Thüringen (Thuringia).
+	DETH GermanState = "DE-TH"
+)
+
+// GermanHolidays is a HolidayCalendar for the public holidays in Germany.
+//
+// Nationwide holidays are always included. State-specific holidays
+// (e.g. Fronleichnam, Reformationstag, Buß- und Bettag) are included for the
+// states passed to NewGermanHolidays.
+//
+// Easter-based holidays are computed for any year. Year-dependent changes in
+// legislation since reunification are taken into account, for example
+// Reformationstag (nationwide in 2017, in HB/HH/NI/SH since 2018) and the
+// Internationaler Frauentag (BE since 2019, MV since 2023).
+//
+// Holidays that apply only in some municipalities of a state are not
+// included: Mariä Himmelfahrt in Bavaria, Fronleichnam in parts of Saxony
+// and Thuringia, and the Augsburger Friedensfest.
+//
+// Holiday names are in German.
+type GermanHolidays struct {
+	states []GermanState
+}
+
+// NewGermanHolidays returns a holiday calendar for Germany.
+// Without states, only nationwide holidays are included. With one or more
+// states, the holidays of each state are included as well.
+//
+// Example:
+//
+//	federal := quando.NewGermanHolidays()
+//	bavaria := quando.NewGermanHolidays(quando.DEBY)
+//	date := quando.MustParse("2026-06-04") // Fronleichnam
+//	date.IsHoliday(federal) // false
+//	date.IsHoliday(bavaria) // true
+func NewGermanHolidays(states ...GermanState) *GermanHolidays {
+	return &GermanHolidays{states: slices.Clone(states)}
+}
+
+// IsHoliday reports whether the calendar day of d is a public holiday.
+// The calendar day is taken in d's location.
+func (g *GermanHolidays) IsHoliday(d Date) bool {
+	day := civilDateOf(d.t)
+	for _, h := range g.Holidays(day.year) {
+		if civilDateOf(h.Date.t) == day {
+			return true
+		}
+	}
+	return false
+}
+
+// Holidays returns all public holidays of the given year in chronological order.
+// The dates are at 00:00 UTC.
+//
+// Example:
+//
+//	for _, h := range quando.NewGermanHolidays(quando.DESN).Holidays(2026) {
+//	    fmt.Println(h.Date.Format(quando.ISO), h.Name)
+//	}
+func (g *GermanHolidays) Holidays(year int) []Holiday {
+	easter := easterSunday(year)
+
+	var holidays []Holiday
+	add := func(t time.Time, name string) {
+		holidays = append(holidays, Holiday{Date: From(t), Name: name})
+	}
+	fixed := func(month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	fromEaster := func(days int) time.Time {
+		return easter.AddDate(0, 0, days)
+	}
+
+	// Nationwide holidays
+	add(fixed(time.January, 1), "Neujahr")
+	add(fromEaster(-2), "Karfreitag")
+	add(fromEaster(1), "Ostermontag")
+	add(fixed(time.May, 1), "Tag der Arbeit")
+	add(fromEaster(39), "Christi Himmelfahrt")
+	add(fromEaster(50), "Pfingstmontag")
+	if year >= 1990 {
+		add(fixed(time.October, 3), "Tag der Deutschen Einheit")
+	}
+	add(fixed(time.December, 25), "1. Weihnachtstag")
+	add(fixed(time.December, 26), "2. Weihnachtstag")
+
+	// State-specific holidays
+	if g.observes(DEBW, DEBY, DEST) {
+		add(fixed(time.January, 6), "Heilige Drei Könige")
+	}
+	if (year >= 2019 && g.observes(DEBE)) || (year >= 2023 && g.observes(DEMV)) {
+		add(fixed(time.March, 8), "Internationaler Frauentag")
+	}
+	if g.observes(DEBB) {
+		add(easter, "Ostersonntag")
+		add(fromEaster(49), "Pfingstsonntag")
+	}
+	if (year == 2020 || year == 2025) && g.observes(DEBE) {
+		add(fixed(time.May, 8), "Tag der Befreiung")
+	}
+	if g.observes(DEBW, DEBY, DEHE, DENW, DERP, DESL) {
+		add(fromEaster(60), "Fronleichnam")
+	}
+	if g.observes(DESL) {
+		add(fixed(time.August, 15), "Mariä Himmelfahrt")
+	}
+	if year >= 2019 && g.observes(DETH) {
+		add(fixed(time.September, 20), "Weltkindertag")
+	}
+	if year == 2017 || g.observes(DEBB, DEMV, DESN, DEST, DETH) ||
+		(year >= 2018 && g.observes(DEHB, DEHH, DENI, DESH)) {
+		add(fixed(time.October, 31), "Reformationstag")
+	}
+	if g.observes(DEBW, DEBY, DENW, DERP, DESL) {
+		add(fixed(time.November, 1), "Allerheiligen")
+	}
+	if year < 1995 || g.observes(DESN) {
+		add(repentanceDay(year), "Buß- und Bettag")
+	}
+
+	slices.SortStableFunc(holidays, func(a, b Holiday) int {
+		return a.Date.Compare(b.Date)
+	})
+	return holidays
+}
+
+// observes reports whether any of the calendar's states is in the given list.
+func (g *GermanHolidays) observes(states ...GermanState) bool {
+	for _, s := range g.states {
+		if slices.Contains(states, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// repentanceDay returns Buß- und Bettag: the Wednesday before November 23.
+func repentanceDay(year int) time.Time {
+	nov22 := time.Date(year, time.November, 22, 0, 0, 0, 0, time.UTC)
+	back := (int(nov22.Weekday()) - int(time.Wednesday) + 7) % 7
+	return nov22.AddDate(0, 0, -back)
+}
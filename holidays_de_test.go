@@ -0,0 +1,206 @@
+package quando
+
+import (
+	"testing"
+	"time"
+)
+
+// Compile-time interface check
+var _ HolidayCalendar = (*GermanHolidays)(nil)
+
+func TestRepentanceDay(t *testing.T) {
+	tests := []struct {
+		year     int
+		expected string
+	}{
+		{2022, "2022-11-16"}, // Nov 22 is a Tuesday
+		{2023, "2023-11-22"}, // Nov 22 is a Wednesday
+		{2024, "2024-11-20"},
+		{2025, "2025-11-19"},
+		{2026, "2026-11-18"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			got := repentanceDay(tt.year)
+			if got.Format("2006-01-02") != tt.expected {
+				t.Errorf("repentanceDay(%d) = %v, want %v", tt.year, got.Format("2006-01-02"), tt.expected)
+			}
+			if got.Weekday() != time.Wednesday {
+				t.Errorf("repentanceDay(%d) weekday = %v, want Wednesday", tt.year, got.Weekday())
+			}
+		})
+	}
+}
+
+func TestGermanHolidaysFederal2026(t *testing.T) {
+	expected := []struct {
+		date string
+		name string
+	}{
+		{"2026-01-01", "Neujahr"},
+		{"2026-04-03", "Karfreitag"},
+		{"2026-04-06", "Ostermontag"},
+		{"2026-05-01", "Tag der Arbeit"},
+		{"2026-05-14", "Christi Himmelfahrt"},
+		{"2026-05-25", "Pfingstmontag"},
+		{"2026-10-03", "Tag der Deutschen Einheit"},
+		{"2026-12-25", "1. Weihnachtstag"},
+		{"2026-12-26", "2. Weihnachtstag"},
+	}
+
+	holidays := NewGermanHolidays().Holidays(2026)
+	if len(holidays) != len(expected) {
+		t.Fatalf("Holidays(2026) returned %d holidays, want %d: %v", len(holidays), len(expected), holidays)
+	}
+
+	for i, h := range holidays {
+		if h.Date.Format(ISO) != expected[i].date || h.Name != expected[i].name {
+			t.Errorf("Holidays(2026)[%d] = %v %q, want %v %q",
+				i, h.Date.Format(ISO), h.Name, expected[i].date, expected[i].name)
+		}
+		if h.Date.Time().Location() != time.UTC || h.Date.Time().Hour() != 0 {
+			t.Errorf("Holidays(2026)[%d] = %v, want 00:00 UTC", i, h.Date.Time())
+		}
+	}
+}
+
+func TestGermanHolidaysPerState(t *testing.T) {
+	tests := []struct {
+		name    string
+		state   GermanState
+		year    int
+		holiday string
+		date    string
+		want    bool
+	}{
+		{"Heilige Drei Könige in BW", DEBW, 2026, "Heilige Drei Könige", "2026-01-06", true},
+		{"Heilige Drei Könige in ST", DEST, 2026, "Heilige Drei Könige", "2026-01-06", true},
+		{"Heilige Drei Könige not in NW", DENW, 2026, "Heilige Drei Könige", "2026-01-06", false},
+		{"Frauentag in BE", DEBE, 2026, "Internationaler Frauentag", "2026-03-08", true},
+		{"Frauentag in BE before 2019", DEBE, 2018, "Internationaler Frauentag", "2018-03-08", false},
+		{"Frauentag in MV since 2023", DEMV, 2023, "Internationaler Frauentag", "2023-03-08", true},
+		{"Frauentag in MV before 2023", DEMV, 2022, "Internationaler Frauentag", "2022-03-08", false},
+		{"Ostersonntag in BB", DEBB, 2026, "Ostersonntag", "2026-04-05", true},
+		{"Pfingstsonntag in BB", DEBB, 2026, "Pfingstsonntag", "2026-05-24", true},
+		{"Ostersonntag not in BY", DEBY, 2026, "Ostersonntag", "2026-04-05", false},
+		{"Tag der Befreiung in BE 2025", DEBE, 2025, "Tag der Befreiung", "2025-05-08", true},
+		{"Tag der Befreiung not in BE 2026", DEBE, 2026, "Tag der Befreiung", "2026-05-08", false},
+		{"Fronleichnam in BY", DEBY, 2026, "Fronleichnam", "2026-06-04", true},
+		{"Fronleichnam in HE", DEHE, 2026, "Fronleichnam", "2026-06-04", true},
+		{"Fronleichnam not in SN", DESN, 2026, "Fronleichnam", "2026-06-04", false},
+		{"Mariä Himmelfahrt in SL", DESL, 2026, "Mariä Himmelfahrt", "2026-08-15", true},
+		{"Mariä Himmelfahrt not in BY", DEBY, 2026, "Mariä Himmelfahrt", "2026-08-15", false},
+		{"Weltkindertag in TH", DETH, 2026, "Weltkindertag", "2026-09-20", true},
+		{"Weltkindertag in TH before 2019", DETH, 2018, "Weltkindertag", "2018-09-20", false},
+		{"Reformationstag in SN", DESN, 2026, "Reformationstag", "2026-10-31", true},
+		{"Reformationstag in HH since 2018", DEHH, 2018, "Reformationstag", "2018-10-31", true},
+		{"Reformationstag in HH before 2018", DEHH, 2016, "Reformationstag", "2016-10-31", false},
+		{"Reformationstag nationwide 2017", DEBY, 2017, "Reformationstag", "2017-10-31", true},
+		{"Reformationstag not in BY", DEBY, 2026, "Reformationstag", "2026-10-31", false},
+		{"Allerheiligen in NW", DENW, 2026, "Allerheiligen", "2026-11-01", true},
+		{"Allerheiligen not in HE", DEHE, 2026, "Allerheiligen", "2026-11-01", false},
+		{"Buß- und Bettag in SN", DESN, 2026, "Buß- und Bettag", "2026-11-18", true},
+		{"Buß- und Bettag not in BY", DEBY, 2026, "Buß- und Bettag", "2026-11-18", false},
+		{"Buß- und Bettag nationwide before 1995", DEBY, 1994, "Buß- und Bettag", "1994-11-16", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cal := NewGermanHolidays(tt.state)
+
+			found := false
+			for _, h := range cal.Holidays(tt.year) {
+				if h.Name == tt.holiday {
+					found = true
+					if h.Date.Format(ISO) != tt.date {
+						t.Errorf("%s date = %v, want %v", tt.holiday, h.Date.Format(ISO), tt.date)
+					}
+				}
+			}
+			if found != tt.want {
+				t.Errorf("%s in Holidays(%d) = %v, want %v", tt.holiday, tt.year, found, tt.want)
+			}
+
+			if got := cal.IsHoliday(MustParse(tt.date)); got != tt.want {
+				t.Errorf("IsHoliday(%v) = %v, want %v", tt.date, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGermanHolidaysCount(t *testing.T) {
+	tests := []struct {
+		name     string
+		states   []GermanState
+		year     int
+		expected int
+	}{
+		{"federal 2026", nil, 2026, 9},
+		{"federal 2017", nil, 2017, 10},
+		{"BY 2026", []GermanState{DEBY}, 2026, 12},
+		{"BE 2026", []GermanState{DEBE}, 2026, 10},
+		{"BB 2026", []GermanState{DEBB}, 2026, 12},
+		{"SN 2026", []GermanState{DESN}, 2026, 11},
+		{"SL 2026", []GermanState{DESL}, 2026, 12},
+		{"TH 2026", []GermanState{DETH}, 2026, 11},
+		{"BY and SN 2026", []GermanState{DEBY, DESN}, 2026, 14},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			holidays := NewGermanHolidays(tt.states...).Holidays(tt.year)
+			if len(holidays) != tt.expected {
+				t.Errorf("Holidays(%d) returned %d holidays, want %d: %v", tt.year, len(holidays), tt.expected, holidays)
+			}
+			for i := 1; i < len(holidays); i++ {
+				if holidays[i].Date.Before(holidays[i-1].Date) {
+					t.Errorf("Holidays(%d) not sorted at index %d", tt.year, i)
+				}
+			}
+		})
+	}
+}
+
+func TestGermanHolidaysIsHolidayTimezone(t *testing.T) {
+	cal := NewGermanHolidays()
+
+	// 23:30 UTC on Dec 31 is already New Year's Day in Berlin
+	date := From(time.Date(2026, 12, 31, 23, 30, 0, 0, time.UTC))
+	if cal.IsHoliday(date) {
+		t.Error("IsHoliday(Dec 31 UTC) = true, want false")
+	}
+
+	berlin := From(date.Time().In(time.FixedZone("CET", 3600)))
+	if !cal.IsHoliday(berlin) {
+		t.Error("IsHoliday(Jan 1 CET) = false, want true")
+	}
+}
+
+func TestGermanHolidaysBusinessDays(t *testing.T) {
+	bavaria := NewGermanHolidays(DEBY)
+
+	// Wednesday before Fronleichnam (Thursday, June 4, 2026)
+	date := MustParse("2026-06-03")
+	if got := date.AddBusinessDays(1, bavaria).Format(ISO); got != "2026-06-05" {
+		t.Errorf("AddBusinessDays(1) in BY = %v, want 2026-06-05", got)
+	}
+	if got := date.AddBusinessDays(1, NewGermanHolidays()).Format(ISO); got != "2026-06-04" {
+		t.Errorf("AddBusinessDays(1) federal = %v, want 2026-06-04", got)
+	}
+
+	// Maundy Thursday to the Tuesday after Easter skips Good Friday and Easter Monday
+	if got := MustParse("2026-04-02").NextBusinessDay(bavaria).Format(ISO); got != "2026-04-07" {
+		t.Errorf("NextBusinessDay() over Easter = %v, want 2026-04-07", got)
+	}
+}
+
+func TestNewGermanHolidaysCopiesStates(t *testing.T) {
+	states := []GermanState{DEBY}
+	cal := NewGermanHolidays(states...)
+	states[0] = DEHB
+
+	if !cal.IsHoliday(MustParse("2026-06-04")) {
+		t.Error("NewGermanHolidays should not be affected by later changes to the states slice")
+	}
+}
@@ -0,0 +1,150 @@
+package quando
+
+import (
+	"slices"
+	"time"
+)
+
+// USFederalHolidays is a HolidayCalendar for the federal holidays of the
+// United States (5 U.S.C. 6103).
+//
+// Holidays on a fixed date are moved to their observed date when they fall on
+// a weekend: a Saturday holiday is observed on the preceding Friday, a Sunday
+// holiday on the following Monday. IsHoliday and Holidays use the observed
+// dates, which is what matters for business-day calculations. Note that
+// New Year's Day falling on a Saturday is observed on December 31 of the
+// previous year.
+//
+// Holidays defined as "nth weekday of a month" (e.g. Thanksgiving) follow the
+// Uniform Monday Holiday Act rules in effect since 1971. Before 1971,
+// Washington's Birthday (February 22), Memorial Day (May 30) and Columbus Day
+// (October 12) are on their fixed dates. Veterans Day is the fourth Monday in
+// October from 1971 to 1977 and November 11 otherwise. Martin Luther King Jr.
+// Day is included from 1986 and Juneteenth from 2021. Inauguration Day, which
+// only applies to the Washington, D.C. area, is not included.
+//
+// The weekend observance rules above are applied to all years. Older changes,
+// such as the date of Thanksgiving before 1942, are not modeled, so results
+// are accurate from 1942 and complete from 1971.
+type USFederalHolidays struct{}
+
+// NewUSFederalHolidays returns a holiday calendar for US federal holidays.
+//
+// Example:
+//
+//	us := quando.NewUSFederalHolidays()
+//	date := quando.MustParse("2026-11-26") // Thanksgiving
+//	date.IsHoliday(us)     // true
+//	date.IsBusinessDay(us) // false
+func NewUSFederalHolidays() *USFederalHolidays {
+	return &USFederalHolidays{}
+}
+
+// IsHoliday reports whether the calendar day of d is an (observed) federal
+// holiday. The calendar day is taken in d's location.
+func (u *USFederalHolidays) IsHoliday(d Date) bool {
+	day := civilDateOf(d.t)
+	for _, h := range u.Holidays(day.year) {
+		if civilDateOf(h.Date.t) == day {
+			return true
+		}
+	}
+	return false
+}
+
+// Holidays returns all federal holidays observed in the given year in
+// chronological order. The dates are the observed dates at 00:00 UTC; holidays
+// moved away from a weekend have " (observed)" appended to their name.
+//
+// Because New Year's Day on a Saturday is observed on December 31 of the
+// previous year, the list for such a year does not contain New Year's Day,
+// and the list of the previous year contains it twice.
+//
+// Example:
+//
+//	for _, h := range quando.NewUSFederalHolidays().Holidays(2026) {
+//	    fmt.Println(h.Date.Format(quando.ISO), h.Name)
+//	}
+func (u *USFederalHolidays) Holidays(year int) []Holiday {
+	var holidays []Holiday
+	add := func(t time.Time, name string) {
+		if t.Year() == year {
+			holidays = append(holidays, Holiday{Date: From(t), Name: name})
+		}
+	}
+	fixed := func(y int, month time.Month, day int, name string) {
+		actual := time.Date(y, month, day, 0, 0, 0, 0, time.UTC)
+		observed := observedDate(actual)
+		if !observed.Equal(actual) {
+			name += " (observed)"
+		}
+		add(observed, name)
+	}
+	nth := func(month time.Month, weekday time.Weekday, n int, name string) {
+		add(nthWeekdayOfMonth(year, month, weekday, n, time.UTC), name)
+	}
+
+	fixed(year, time.January, 1, "New Year's Day")
+	if year >= 1986 {
+		nth(time.January, time.Monday, 3, "Martin Luther King Jr. Day")
+	}
+	// Uniform Monday Holiday Act, effective 1971
+	monday := year >= 1971
+	if monday {
+		nth(time.February, time.Monday, 3, "Washington's Birthday")
+		nth(time.May, time.Monday, -1, "Memorial Day")
+	} else {
+		fixed(year, time.February, 22, "Washington's Birthday")
+		fixed(year, time.May, 30, "Memorial Day")
+	}
+	if year >= 2021 {
+		fixed(year, time.June, 19, "Juneteenth National Independence Day")
+	}
+	fixed(year, time.July, 4, "Independence Day")
+	nth(time.September, time.Monday, 1, "Labor Day")
+	if monday {
+		nth(time.October, time.Monday, 2, "Columbus Day")
+	} else {
+		fixed(year, time.October, 12, "Columbus Day")
+	}
+	// Veterans Day returned to November 11 in 1978
+	if year >= 1971 && year <= 1977 {
+		nth(time.October, time.Monday, 4, "Veterans Day")
+	} else {
+		fixed(year, time.November, 11, "Veterans Day")
+	}
+	nth(time.November, time.Thursday, 4, "Thanksgiving Day")
+	fixed(year, time.December, 25, "Christmas Day")
+
+	// New Year's Day of the following year may be observed on December 31
+	fixed(year+1, time.January, 1, "New Year's Day")
+
+	slices.SortStableFunc(holidays, func(a, b Holiday) int {
+		return a.Date.Compare(b.Date)
+	})
+	return holidays
+}
+
+// observedDate moves a holiday falling on a weekend to the nearest weekday:
+// Saturday to the preceding Friday, Sunday to the following Monday.
+func observedDate(t time.Time) time.Time {
+	switch t.Weekday() {
+	case time.Saturday:
+		return t.AddDate(0, 0, -1)
+	case time.Sunday:
+		return t.AddDate(0, 0, 1)
+	default:
+		return t
+	}
+}
+
+// nthWeekdayOfMonth returns the nth occurrence of weekday in the given month
+// at 00:00 in loc. Positive n counts from the start of the month (1 = first),
+// negative n counts from the end (-1 = last). n must not exceed the number of
+// occurrences in the month.
+func nthWeekdayOfMonth(year int, month time.Month, weekday time.Weekday, n int, loc *time.Location) time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, loc)
+	last := time.Date(year, month+1, 0, 0, 0, 0, 0, loc)
+	day, _ := nthWeekdayBetween(first, last, weekday, n)
+	return day
+}
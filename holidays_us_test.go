@@ -0,0 +1,213 @@
+package quando
+
+import (
+	"testing"
+	"time"
+)
+
+// Compile-time interface check
+var _ HolidayCalendar = (*USFederalHolidays)(nil)
+
+func TestNthWeekdayOfMonth(t *testing.T) {
+	tests := []struct {
+		name     string
+		year     int
+		month    time.Month
+		weekday  time.Weekday
+		n        int
+		expected string
+	}{
+		{"first Monday, month starts Monday", 2026, time.June, time.Monday, 1, "2026-06-01"},
+		{"first Monday, month starts Tuesday", 2026, time.September, time.Monday, 1, "2026-09-07"},
+		{"third Monday", 2026, time.January, time.Monday, 3, "2026-01-19"},
+		{"fourth Thursday", 2026, time.November, time.Thursday, 4, "2026-11-26"},
+		{"fifth Friday", 2026, time.January, time.Friday, 5, "2026-01-30"},
+		{"last Monday, month ends Sunday", 2026, time.May, time.Monday, -1, "2026-05-25"},
+		{"last Sunday, month ends Sunday", 2026, time.May, time.Sunday, -1, "2026-05-31"},
+		{"second to last Friday", 2026, time.February, time.Friday, -2, "2026-02-20"},
+		{"last day of February in leap year", 2028, time.February, time.Tuesday, -1, "2028-02-29"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := nthWeekdayOfMonth(tt.year, tt.month, tt.weekday, tt.n, time.UTC)
+			if got.Format("2006-01-02") != tt.expected {
+				t.Errorf("nthWeekdayOfMonth(%d, %v, %v, %d) = %v, want %v",
+					tt.year, tt.month, tt.weekday, tt.n, got.Format("2006-01-02"), tt.expected)
+			}
+			if got.Weekday() != tt.weekday {
+				t.Errorf("nthWeekdayOfMonth() weekday = %v, want %v", got.Weekday(), tt.weekday)
+			}
+		})
+	}
+}
+
+func TestObservedDate(t *testing.T) {
+	tests := []struct {
+		date     string
+		expected string
+	}{
+		{"2026-07-03", "2026-07-03"}, // Friday
+		{"2026-07-04", "2026-07-03"}, // Saturday
+		{"2027-07-04", "2027-07-05"}, // Sunday
+		{"2022-01-01", "2021-12-31"}, // Saturday, previous year
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			got := observedDate(MustParse(tt.date).Time()).Format("2006-01-02")
+			if got != tt.expected {
+				t.Errorf("observedDate(%v) = %v, want %v", tt.date, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUSFederalHolidays2026(t *testing.T) {
+	expected := []struct {
+		date string
+		name string
+	}{
+		{"2026-01-01", "New Year's Day"},
+		{"2026-01-19", "Martin Luther King Jr. Day"},
+		{"2026-02-16", "Washington's Birthday"},
+		{"2026-05-25", "Memorial Day"},
+		{"2026-06-19", "Juneteenth National Independence Day"},
+		{"2026-07-03", "Independence Day (observed)"},
+		{"2026-09-07", "Labor Day"},
+		{"2026-10-12", "Columbus Day"},
+		{"2026-11-11", "Veterans Day"},
+		{"2026-11-26", "Thanksgiving Day"},
+		{"2026-12-25", "Christmas Day"},
+	}
+
+	holidays := NewUSFederalHolidays().Holidays(2026)
+	if len(holidays) != len(expected) {
+		t.Fatalf("Holidays(2026) returned %d holidays, want %d: %v", len(holidays), len(expected), holidays)
+	}
+
+	for i, h := range holidays {
+		if h.Date.Format(ISO) != expected[i].date || h.Name != expected[i].name {
+			t.Errorf("Holidays(2026)[%d] = %v %q, want %v %q",
+				i, h.Date.Format(ISO), h.Name, expected[i].date, expected[i].name)
+		}
+		if h.Date.Time().Location() != time.UTC || h.Date.Time().Hour() != 0 {
+			t.Errorf("Holidays(2026)[%d] = %v, want 00:00 UTC", i, h.Date.Time())
+		}
+	}
+}
+
+func TestUSFederalHolidaysObserved(t *testing.T) {
+	tests := []struct {
+		name    string
+		year    int
+		holiday string
+		date    string
+	}{
+		{"Christmas on Saturday", 2021, "Christmas Day (observed)", "2021-12-24"},
+		{"Christmas on Sunday", 2022, "Christmas Day (observed)", "2022-12-26"},
+		{"Veterans Day on Saturday", 2023, "Veterans Day (observed)", "2023-11-10"},
+		{"Juneteenth on Sunday", 2022, "Juneteenth National Independence Day (observed)", "2022-06-20"},
+		{"Independence Day on Sunday", 2027, "Independence Day (observed)", "2027-07-05"},
+		{"New Year's Day on Sunday", 2023, "New Year's Day (observed)", "2023-01-02"},
+		{"New Year's Day 2022 observed in 2021", 2021, "New Year's Day (observed)", "2021-12-31"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cal := NewUSFederalHolidays()
+
+			found := false
+			for _, h := range cal.Holidays(tt.year) {
+				if h.Name == tt.holiday && h.Date.Format(ISO) == tt.date {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("Holidays(%d) does not contain %v %q", tt.year, tt.date, tt.holiday)
+			}
+
+			if !cal.IsHoliday(MustParse(tt.date)) {
+				t.Errorf("IsHoliday(%v) = false, want true", tt.date)
+			}
+		})
+	}
+}
+
+func TestUSFederalHolidaysNewYearOnSaturday(t *testing.T) {
+	cal := NewUSFederalHolidays()
+
+	// Jan 1, 2022 is a Saturday and observed on Dec 31, 2021
+	for _, h := range cal.Holidays(2022) {
+		if h.Date.Format(ISO) == "2022-01-01" || h.Name == "New Year's Day" || h.Name == "New Year's Day (observed)" {
+			t.Errorf("Holidays(2022) contains %v %q, want New Year's Day in 2021", h.Date.Format(ISO), h.Name)
+		}
+	}
+	if cal.IsHoliday(MustParse("2022-01-01")) {
+		t.Error("IsHoliday(2022-01-01) = true, want false (observed on 2021-12-31)")
+	}
+
+	// 2021 contains New Year's Day twice: Jan 1, 2021 and the observed Jan 1, 2022
+	if got := len(cal.Holidays(2021)); got != 12 {
+		t.Errorf("Holidays(2021) returned %d holidays, want 12", got)
+	}
+}
+
+func TestUSFederalHolidaysHistory(t *testing.T) {
+	tests := []struct {
+		name string
+		date string
+		want bool
+	}{
+		{"MLK Day 1986", "1986-01-20", true},
+		{"no MLK Day 1985", "1985-01-21", false},
+		{"Juneteenth 2021", "2021-06-18", true}, // Saturday, observed Friday
+		{"no Juneteenth 2020", "2020-06-19", false},
+		{"Thanksgiving 1999", "1999-11-25", true},
+		{"Washington's Birthday 1968 fixed", "1968-02-22", true},
+		{"no Monday Washington's Birthday 1968", "1968-02-19", false},
+		{"Memorial Day 1968 fixed", "1968-05-30", true},
+		{"no Monday Memorial Day 1968", "1968-05-27", false},
+		{"Columbus Day 1967 fixed", "1967-10-12", true},
+		{"no Monday Columbus Day 1967", "1967-10-09", false},
+		{"Washington's Birthday 1971 Monday", "1971-02-15", true},
+		{"Veterans Day 1971 in October", "1971-10-25", true},
+		{"Veterans Day 1975 in October", "1975-10-27", true},
+		{"no Veterans Day 1975 November 11", "1975-11-11", false},
+		{"Veterans Day 1978 November 11", "1978-11-10", true}, // Saturday, observed Friday
+		{"Veterans Day 1980", "1980-11-11", true},
+		{"regular weekday", "2026-03-10", false},
+	}
+
+	cal := NewUSFederalHolidays()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cal.IsHoliday(MustParse(tt.date)); got != tt.want {
+				t.Errorf("IsHoliday(%v) = %v, want %v", tt.date, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUSFederalHolidaysBusinessDays(t *testing.T) {
+	us := NewUSFederalHolidays()
+
+	// Wednesday before Thanksgiving 2026
+	if got := MustParse("2026-11-25").NextBusinessDay(us).Format(ISO); got != "2026-11-27" {
+		t.Errorf("NextBusinessDay() over Thanksgiving = %v, want 2026-11-27", got)
+	}
+
+	// Thursday before observed Independence Day (Friday, July 3, 2026)
+	if got := MustParse("2026-07-02").NextBusinessDay(us).Format(ISO); got != "2026-07-06" {
+		t.Errorf("NextBusinessDay() over July 4th weekend = %v, want 2026-07-06", got)
+	}
+
+	// US and German calendars combined
+	de := NewGermanHolidays()
+	if MustParse("2026-10-03").IsBusinessDay(us, de) {
+		t.Error("IsBusinessDay(2026-10-03) = true, want false (Saturday)")
+	}
+	if MustParse("2026-12-26").IsHoliday(us) {
+		t.Error("IsHoliday(2026-12-26, us) = true, want false")
+	}
+}
@@ -6,9 +6,9 @@ import "time"
 // Returned by the Info() method.
 type DateInfo struct {
 	WeekNumber int   // ISO 8601 week number (1-53)
-	Quarter    int   // Fiscal quarter (1-4)
+	Quarter    int   // Calendar quarter (1-4)
 	DayOfYear  int   // Day of year (1-366)
-	IsWeekend  bool  // True if Saturday or Sunday
+	IsWeekend  bool  // True if the date falls on a weekend (see IsWeekend)
 	IsLeapYear bool  // True if date is in a leap year
 	Unix       int64 // Unix timestamp
 }
@@ -38,7 +38,39 @@ func (d Date) WeekNumber() int {
 	return week
 }
 
-// Quarter returns the fiscal quarter (1-4) for the date.
+// WeekOfYear returns the week number (1-53) using the date's week start
+// (see WithWeekStart).
+//
+// Week numbering depends on the week start:
+//   - Monday (default): ISO 8601 week number, identical to WeekNumber
+//   - Any other day: week 1 is the week containing January 1, as in the US
+//     and Middle East conventions. Dates in late December that share a week
+//     with the next January 1 belong to week 1.
+//
+// Example:
+//
+//	date := quando.From(time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC)) // Sunday
+//	date.WeekOfYear()                            // 1 (ISO)
+//	date.WithWeekStart(time.Sunday).WeekOfYear() // 2
+func (d Date) WeekOfYear() int {
+	if d.WeekStart() == time.Monday {
+		return d.WeekNumber()
+	}
+
+	// Work on civil dates in UTC so DST transitions cannot skew day counts
+	year, month, day := d.t.Date()
+	weekStart := time.Date(year, month, day-d.daysIntoWeek(), 0, 0, 0, 0, time.UTC)
+	if weekStart.AddDate(0, 0, 6).Year() > year {
+		return 1
+	}
+
+	jan1 := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	firstWeekStart := jan1.AddDate(0, 0, -d.with(jan1).daysIntoWeek())
+	return int(weekStart.Sub(firstWeekStart).Hours())/(7*24) + 1
+}
+
+// Quarter returns the calendar quarter (1-4) for the date.
+// For fiscal years that do not start in January, see FiscalQuarter.
 //
 // Quarter mapping:
 //   - Q1: January, February, March
@@ -81,10 +113,11 @@ func (d Date) DayOfYear() int {
 	return d.t.YearDay()
 }
 
-// IsWeekend returns true if the date falls on a weekend (Saturday or Sunday).
+// IsWeekend returns true if the date falls on a weekend.
 //
-// Note: This uses the ISO convention where Saturday and Sunday are considered
-// weekend days. This is not configurable in Phase 1.
+// By default, Saturday and Sunday are weekend days (ISO convention). Use
+// WithWeekend to attach a different weekend definition, e.g. FridaySaturday
+// or one derived from a locale with WeekendFor.
 //
 // Performance: < 1 µs, zero allocations
 //
@@ -92,9 +125,11 @@ func (d Date) DayOfYear() int {
 //
 //	date := quando.From(time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC)) // Monday
 //	isWeekend := date.IsWeekend() // false
+//
+//	friday := quando.From(time.Date(2026, 2, 13, 0, 0, 0, 0, time.UTC))
+//	friday.WithWeekend(quando.FridaySaturday).IsWeekend() // true
 func (d Date) IsWeekend() bool {
-	weekday := d.t.Weekday()
-	return weekday == time.Saturday || weekday == time.Sunday
+	return d.weekend.Has(d.t.Weekday())
 }
 
 // IsLeapYear returns true if the date's year is a leap year.
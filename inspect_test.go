@@ -390,3 +390,53 @@ func TestInfo_ConsistentWithIndividualMethods(t *testing.T) {
 	}
 }
 
+func TestWeekOfYear(t *testing.T) {
+	tests := []struct {
+		name      string
+		date      time.Time
+		weekStart time.Weekday
+		want      int
+	}{
+		// Monday start is ISO 8601
+		{"ISO Jan 4 2026 Sunday", time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC), time.Monday, 1},
+		{"ISO Jan 1 2023 Sunday", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Monday, 52},
+		{"ISO Dec 29 2025 Monday", time.Date(2025, 12, 29, 0, 0, 0, 0, time.UTC), time.Monday, 1},
+
+		// Sunday start: week 1 contains January 1
+		{"Sunday Jan 1 2026 Thursday", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Sunday, 1},
+		{"Sunday Jan 3 2026 Saturday", time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC), time.Sunday, 1},
+		{"Sunday Jan 4 2026 Sunday", time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC), time.Sunday, 2},
+		{"Sunday Feb 9 2026 Monday", time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC), time.Sunday, 7},
+		{"Sunday Dec 26 2026 Saturday", time.Date(2026, 12, 26, 0, 0, 0, 0, time.UTC), time.Sunday, 52},
+		{"Sunday Dec 27 2026 belongs to next year", time.Date(2026, 12, 27, 0, 0, 0, 0, time.UTC), time.Sunday, 1},
+		{"Sunday Dec 31 2025 belongs to next year", time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC), time.Sunday, 1},
+		{"Sunday Jan 1 2017 Sunday", time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), time.Sunday, 1},
+		{"Sunday Dec 31 2022 week 53", time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC), time.Sunday, 53},
+
+		// Saturday start
+		{"Saturday Jan 2 2026 Friday", time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), time.Saturday, 1},
+		{"Saturday Jan 3 2026 Saturday", time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC), time.Saturday, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := From(tt.date).WithWeekStart(tt.weekStart).WeekOfYear()
+			if got != tt.want {
+				t.Errorf("WeekOfYear() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWeekOfYear_DST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("timezone data not available: %v", err)
+	}
+
+	// DST starts on Sunday, March 8, 2026 in New York
+	date := From(time.Date(2026, 3, 14, 23, 0, 0, 0, loc)).WithWeekStart(time.Sunday)
+	if got := date.WeekOfYear(); got != 11 {
+		t.Errorf("WeekOfYear() = %d, want 11", got)
+	}
+}
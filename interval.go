@@ -0,0 +1,209 @@
+package quando
+
+import (
+	"slices"
+	"time"
+)
+
+// Overlaps reports whether the two ranges share at least one instant.
+// Half-open ranges that merely touch (one ends where the other starts) do not
+// overlap. Empty ranges never overlap anything.
+//
+// Example:
+//
+//	a := quando.NewRange(quando.MustParse("2026-07-01"), quando.MustParse("2026-07-08"))
+//	b := quando.NewRange(quando.MustParse("2026-07-05"), quando.MustParse("2026-07-12"))
+//	a.Overlaps(b) // true
+func (r Range) Overlaps(other Range) bool {
+	_, ok := r.Intersect(other)
+	return ok
+}
+
+// Intersect returns the range of instants contained in both ranges.
+// The second return value is false, and the returned range is the zero Range,
+// if the ranges do not overlap.
+//
+// The result starts at the later of the two starts and ends at the earlier of
+// the two ends. Its end is inclusive only if that end is inclusive in both
+// ranges.
+//
+// Example:
+//
+//	a := quando.NewRange(quando.MustParse("2026-07-01"), quando.MustParse("2026-07-08"))
+//	b := quando.NewRange(quando.MustParse("2026-07-05"), quando.MustParse("2026-07-12"))
+//	both, ok := a.Intersect(b) // [2026-07-05, 2026-07-08), true
+func (r Range) Intersect(other Range) (Range, bool) {
+	start := r.start
+	if other.start.t.After(start.t) {
+		start = other.start
+	}
+
+	end := r
+	if compareEnds(other, r) < 0 {
+		end = other
+	}
+
+	result := Range{start: start, end: end.end, inclusive: end.inclusive}
+	if r.IsEmpty() || other.IsEmpty() || result.IsEmpty() {
+		return Range{}, false
+	}
+	return result, true
+}
+
+// Union returns the smallest range containing both ranges. The second return
+// value is false, and the returned range is the zero Range, if the union is
+// not a single contiguous range, i.e. if there is a gap between the ranges.
+// Ranges that touch are contiguous: [a, b) ∪ [b, c) = [a, c).
+//
+// Empty ranges are ignored: the union of a range with an empty range is the
+// range itself. The union of two empty ranges is reported as false.
+//
+// Example:
+//
+//	a := quando.NewRange(quando.MustParse("2026-07-01"), quando.MustParse("2026-07-08"))
+//	b := quando.NewRange(quando.MustParse("2026-07-08"), quando.MustParse("2026-07-15"))
+//	both, ok := a.Union(b) // [2026-07-01, 2026-07-15), true
+func (r Range) Union(other Range) (Range, bool) {
+	switch {
+	case r.IsEmpty() && other.IsEmpty():
+		return Range{}, false
+	case other.IsEmpty():
+		return r, true
+	case r.IsEmpty():
+		return other, true
+	}
+
+	first, second := r, other
+	if compareRanges(second, first) < 0 {
+		first, second = second, first
+	}
+	if second.start.t.After(first.end.t) {
+		return Range{}, false
+	}
+
+	end := first
+	if compareEnds(second, first) > 0 {
+		end = second
+	}
+	return Range{start: first.start, end: end.end, inclusive: end.inclusive}, true
+}
+
+// Subtract returns the parts of r that are not contained in other, in
+// chronological order. The result has no elements if other covers r
+// completely, one element if other overlaps r at one side (or not at all),
+// and two elements if other lies strictly inside r.
+//
+// A part that starts right after an inclusive end of other starts one
+// nanosecond after that end, since ranges always include their start.
+//
+// Example:
+//
+//	day := quando.NewRange(quando.MustParse("2026-07-01 08:00:00"), quando.MustParse("2026-07-01 18:00:00"))
+//	lunch := quando.NewRange(quando.MustParse("2026-07-01 12:00:00"), quando.MustParse("2026-07-01 13:00:00"))
+//	day.Subtract(lunch) // [08:00, 12:00), [13:00, 18:00)
+func (r Range) Subtract(other Range) []Range {
+	if r.IsEmpty() {
+		return nil
+	}
+	if !r.Overlaps(other) {
+		return []Range{r}
+	}
+
+	var result []Range
+	if other.start.t.After(r.start.t) {
+		result = append(result, Range{start: r.start, end: other.start})
+	}
+
+	start := other.end
+	if other.inclusive {
+		start = other.end.with(other.end.t.Add(time.Nanosecond))
+	}
+	if right := (Range{start: start, end: r.end, inclusive: r.inclusive}); !right.IsEmpty() {
+		result = append(result, right)
+	}
+	return result
+}
+
+// Gaps returns the parts of r that are not covered by any of the busy ranges,
+// in chronological order. Busy ranges may overlap each other and may extend
+// beyond r.
+//
+// Example:
+//
+//	month := quando.NewRange(quando.MustParse("2026-07-01"), quando.MustParse("2026-08-01"))
+//	free := month.Gaps(bookings...) // all free periods in July
+func (r Range) Gaps(busy ...Range) []Range {
+	if r.IsEmpty() {
+		return nil
+	}
+
+	// Busy ranges are merged and sorted, so only the last free part can
+	// intersect the next one
+	free := []Range{r}
+	for _, b := range Merge(busy) {
+		last := free[len(free)-1]
+		free = append(free[:len(free)-1], last.Subtract(b)...)
+		if len(free) == 0 {
+			break
+		}
+	}
+	return free
+}
+
+// Merge combines overlapping and touching ranges and returns the resulting
+// disjoint ranges sorted by start. Empty ranges are dropped. The input slice
+// is not modified.
+//
+// Example:
+//
+//	merged := quando.Merge([]quando.Range{
+//	    quando.NewRange(quando.MustParse("2026-07-05"), quando.MustParse("2026-07-10")),
+//	    quando.NewRange(quando.MustParse("2026-07-01"), quando.MustParse("2026-07-06")),
+//	    quando.NewRange(quando.MustParse("2026-07-20"), quando.MustParse("2026-07-25")),
+//	})
+//	// [2026-07-01, 2026-07-10), [2026-07-20, 2026-07-25)
+func Merge(ranges []Range) []Range {
+	sorted := make([]Range, 0, len(ranges))
+	for _, r := range ranges {
+		if !r.IsEmpty() {
+			sorted = append(sorted, r)
+		}
+	}
+	slices.SortFunc(sorted, compareRanges)
+
+	var merged []Range
+	for _, r := range sorted {
+		if len(merged) > 0 {
+			if union, ok := merged[len(merged)-1].Union(r); ok {
+				merged[len(merged)-1] = union
+				continue
+			}
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// compareRanges orders ranges by start, then by end.
+func compareRanges(a, b Range) int {
+	if c := a.start.t.Compare(b.start.t); c != 0 {
+		return c
+	}
+	return compareEnds(a, b)
+}
+
+// compareEnds orders ranges by their end. An exclusive end comes before an
+// inclusive end at the same instant.
+func compareEnds(a, b Range) int {
+	if c := a.end.t.Compare(b.end.t); c != 0 {
+		return c
+	}
+	switch {
+	case a.inclusive == b.inclusive:
+		return 0
+	case a.inclusive:
+		return 1
+	default:
+		return -1
+	}
+}
@@ -0,0 +1,333 @@
+package quando
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// rng returns the half-open range [start, end) for the given date strings
+func rng(start, end string) Range {
+	return NewRange(MustParse(start), MustParse(end))
+}
+
+// inc returns the inclusive range [start, end] for the given date strings
+func inc(start, end string) Range {
+	return NewInclusiveRange(MustParse(start), MustParse(end))
+}
+
+// rangeStrings formats ranges for comparison in tests
+func rangeStrings(ranges []Range) []string {
+	var result []string
+	for _, r := range ranges {
+		result = append(result, r.String())
+	}
+	return result
+}
+
+func TestRangeOverlaps(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     Range
+		expected bool
+	}{
+		{"overlapping", rng("2026-07-01", "2026-07-08"), rng("2026-07-05", "2026-07-12"), true},
+		{"contained", rng("2026-07-01", "2026-07-31"), rng("2026-07-05", "2026-07-12"), true},
+		{"identical", rng("2026-07-01", "2026-07-08"), rng("2026-07-01", "2026-07-08"), true},
+		{"disjoint", rng("2026-07-01", "2026-07-05"), rng("2026-07-10", "2026-07-12"), false},
+		{"touching half-open", rng("2026-07-01", "2026-07-08"), rng("2026-07-08", "2026-07-12"), false},
+		{"touching inclusive", inc("2026-07-01", "2026-07-08"), rng("2026-07-08", "2026-07-12"), true},
+		{"empty", rng("2026-07-05", "2026-07-05"), rng("2026-07-01", "2026-07-12"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Overlaps(tt.b); got != tt.expected {
+				t.Errorf("%v.Overlaps(%v) = %v, want %v", tt.a, tt.b, got, tt.expected)
+			}
+			if got := tt.b.Overlaps(tt.a); got != tt.expected {
+				t.Errorf("%v.Overlaps(%v) = %v, want %v", tt.b, tt.a, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRangeIntersect(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     Range
+		expected Range
+		ok       bool
+	}{
+		{"overlapping", rng("2026-07-01", "2026-07-08"), rng("2026-07-05", "2026-07-12"), rng("2026-07-05", "2026-07-08"), true},
+		{"contained", rng("2026-07-01", "2026-07-31"), inc("2026-07-05", "2026-07-12"), inc("2026-07-05", "2026-07-12"), true},
+		{"same end, mixed", inc("2026-07-01", "2026-07-08"), rng("2026-07-05", "2026-07-08"), rng("2026-07-05", "2026-07-08"), true},
+		{"same end, both inclusive", inc("2026-07-01", "2026-07-08"), inc("2026-07-05", "2026-07-08"), inc("2026-07-05", "2026-07-08"), true},
+		{"single instant", inc("2026-07-01", "2026-07-08"), rng("2026-07-08", "2026-07-12"), inc("2026-07-08", "2026-07-08"), true},
+		{"touching", rng("2026-07-01", "2026-07-08"), rng("2026-07-08", "2026-07-12"), Range{}, false},
+		{"disjoint", rng("2026-07-01", "2026-07-05"), rng("2026-07-10", "2026-07-12"), Range{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, pair := range [][2]Range{{tt.a, tt.b}, {tt.b, tt.a}} {
+				got, ok := pair[0].Intersect(pair[1])
+				if got != tt.expected || ok != tt.ok {
+					t.Errorf("%v.Intersect(%v) = %v, %v, want %v, %v", pair[0], pair[1], got, ok, tt.expected, tt.ok)
+				}
+			}
+		})
+	}
+}
+
+func TestRangeUnion(t *testing.T) {
+	empty := rng("2026-07-05", "2026-07-05")
+
+	tests := []struct {
+		name     string
+		a, b     Range
+		expected Range
+		ok       bool
+	}{
+		{"overlapping", rng("2026-07-01", "2026-07-08"), rng("2026-07-05", "2026-07-12"), rng("2026-07-01", "2026-07-12"), true},
+		{"touching", rng("2026-07-01", "2026-07-08"), rng("2026-07-08", "2026-07-12"), rng("2026-07-01", "2026-07-12"), true},
+		{"contained", rng("2026-07-01", "2026-07-31"), inc("2026-07-05", "2026-07-12"), rng("2026-07-01", "2026-07-31"), true},
+		{"same end, mixed", inc("2026-07-01", "2026-07-08"), rng("2026-07-05", "2026-07-08"), inc("2026-07-01", "2026-07-08"), true},
+		{"gap", rng("2026-07-01", "2026-07-05"), rng("2026-07-10", "2026-07-12"), Range{}, false},
+		{"with empty", rng("2026-07-01", "2026-07-05"), empty, rng("2026-07-01", "2026-07-05"), true},
+		{"both empty", empty, empty, Range{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, pair := range [][2]Range{{tt.a, tt.b}, {tt.b, tt.a}} {
+				got, ok := pair[0].Union(pair[1])
+				if got != tt.expected || ok != tt.ok {
+					t.Errorf("%v.Union(%v) = %v, %v, want %v, %v", pair[0], pair[1], got, ok, tt.expected, tt.ok)
+				}
+			}
+		})
+	}
+}
+
+func TestRangeSubtract(t *testing.T) {
+	day := rng("2026-07-01 08:00:00", "2026-07-01 18:00:00")
+
+	tests := []struct {
+		name     string
+		r        Range
+		other    Range
+		expected []Range
+	}{
+		{
+			"hole in the middle",
+			day,
+			rng("2026-07-01 12:00:00", "2026-07-01 13:00:00"),
+			[]Range{rng("2026-07-01 08:00:00", "2026-07-01 12:00:00"), rng("2026-07-01 13:00:00", "2026-07-01 18:00:00")},
+		},
+		{
+			"overlapping start",
+			day,
+			rng("2026-07-01 06:00:00", "2026-07-01 09:00:00"),
+			[]Range{rng("2026-07-01 09:00:00", "2026-07-01 18:00:00")},
+		},
+		{
+			"overlapping end",
+			day,
+			rng("2026-07-01 17:00:00", "2026-07-01 20:00:00"),
+			[]Range{rng("2026-07-01 08:00:00", "2026-07-01 17:00:00")},
+		},
+		{
+			"covering",
+			day,
+			rng("2026-07-01 00:00:00", "2026-07-02 00:00:00"),
+			nil,
+		},
+		{
+			"identical",
+			day,
+			day,
+			nil,
+		},
+		{
+			"disjoint",
+			day,
+			rng("2026-07-02 08:00:00", "2026-07-02 18:00:00"),
+			[]Range{day},
+		},
+		{
+			"touching",
+			day,
+			rng("2026-07-01 18:00:00", "2026-07-01 20:00:00"),
+			[]Range{day},
+		},
+		{
+			"inclusive range keeps its end",
+			inc("2026-07-01", "2026-07-10"),
+			rng("2026-07-01", "2026-07-05"),
+			[]Range{inc("2026-07-05", "2026-07-10")},
+		},
+		{
+			"empty range",
+			rng("2026-07-05", "2026-07-05"),
+			rng("2026-07-01", "2026-07-02"),
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rangeStrings(tt.r.Subtract(tt.other))
+			if expected := rangeStrings(tt.expected); !slices.Equal(got, expected) {
+				t.Errorf("%v.Subtract(%v) = %v, want %v", tt.r, tt.other, got, expected)
+			}
+		})
+	}
+}
+
+func TestRangeSubtractInclusiveOther(t *testing.T) {
+	r := rng("2026-07-01", "2026-07-10")
+	got := r.Subtract(inc("2026-07-01", "2026-07-05"))
+
+	if len(got) != 1 {
+		t.Fatalf("Subtract() = %v, want one range", got)
+	}
+	// The end of the subtracted range is excluded from the rest
+	if got[0].Contains(MustParse("2026-07-05")) {
+		t.Errorf("Subtract() = %v, should not contain 2026-07-05 00:00:00", got[0])
+	}
+	if expected := MustParse("2026-07-05").Time().Add(time.Nanosecond); !got[0].Start().Time().Equal(expected) {
+		t.Errorf("Subtract() start = %v, want %v", got[0].Start().Time(), expected)
+	}
+	if got[0].End() != r.End() || got[0].IsInclusive() {
+		t.Errorf("Subtract() = %v, want end %v exclusive", got[0], r.End())
+	}
+}
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name     string
+		ranges   []Range
+		expected []Range
+	}{
+		{"nil", nil, nil},
+		{
+			"unsorted and overlapping",
+			[]Range{
+				rng("2026-07-05", "2026-07-10"),
+				rng("2026-07-20", "2026-07-25"),
+				rng("2026-07-01", "2026-07-06"),
+			},
+			[]Range{rng("2026-07-01", "2026-07-10"), rng("2026-07-20", "2026-07-25")},
+		},
+		{
+			"touching",
+			[]Range{rng("2026-07-08", "2026-07-12"), rng("2026-07-01", "2026-07-08")},
+			[]Range{rng("2026-07-01", "2026-07-12")},
+		},
+		{
+			"contained and duplicates",
+			[]Range{
+				rng("2026-07-01", "2026-07-31"),
+				rng("2026-07-05", "2026-07-06"),
+				rng("2026-07-05", "2026-07-06"),
+			},
+			[]Range{rng("2026-07-01", "2026-07-31")},
+		},
+		{
+			"inclusive end wins",
+			[]Range{rng("2026-07-01", "2026-07-10"), inc("2026-07-05", "2026-07-10")},
+			[]Range{inc("2026-07-01", "2026-07-10")},
+		},
+		{
+			"empty ranges dropped",
+			[]Range{rng("2026-07-05", "2026-07-05"), rng("2026-07-01", "2026-07-02")},
+			[]Range{rng("2026-07-01", "2026-07-02")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Merge(tt.ranges)
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("Merge() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMergeDoesNotModifyInput(t *testing.T) {
+	ranges := []Range{rng("2026-07-05", "2026-07-10"), rng("2026-07-01", "2026-07-06")}
+	original := slices.Clone(ranges)
+
+	Merge(ranges)
+	if !slices.Equal(ranges, original) {
+		t.Errorf("Merge() modified its input: %v, want %v", ranges, original)
+	}
+}
+
+func TestRangeGaps(t *testing.T) {
+	july := rng("2026-07-01", "2026-08-01")
+
+	tests := []struct {
+		name     string
+		r        Range
+		busy     []Range
+		expected []Range
+	}{
+		{"no bookings", july, nil, []Range{july}},
+		{
+			"bookings inside",
+			july,
+			[]Range{
+				rng("2026-07-20", "2026-07-25"),
+				rng("2026-07-05", "2026-07-10"),
+				rng("2026-07-08", "2026-07-12"),
+			},
+			[]Range{
+				rng("2026-07-01", "2026-07-05"),
+				rng("2026-07-12", "2026-07-20"),
+				rng("2026-07-25", "2026-08-01"),
+			},
+		},
+		{
+			"bookings beyond the range",
+			july,
+			[]Range{rng("2026-06-25", "2026-07-03"), rng("2026-07-28", "2026-08-05")},
+			[]Range{rng("2026-07-03", "2026-07-28")},
+		},
+		{
+			"back-to-back bookings",
+			july,
+			[]Range{rng("2026-07-01", "2026-07-15"), rng("2026-07-15", "2026-08-01")},
+			nil,
+		},
+		{
+			"fully booked",
+			july,
+			[]Range{rng("2026-06-01", "2026-09-01")},
+			nil,
+		},
+		{
+			"bookings outside",
+			july,
+			[]Range{rng("2026-05-01", "2026-06-01"), rng("2026-09-01", "2026-10-01")},
+			[]Range{july},
+		},
+		{
+			"empty range",
+			rng("2026-07-01", "2026-07-01"),
+			nil,
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.r.Gaps(tt.busy...)
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("Gaps() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package quando
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Serialization Policy
+//
+// Date implements the standard encoding interfaces so that it can be embedded
+// in structs that are serialized with encoding/json, encoding/xml, encoding/gob
+// and similar packages.
+//
+// Text and JSON:
+//   - Encoded as RFC 3339 with nanosecond precision: "2026-02-09T12:30:45Z"
+//   - Decoding is routed through Parse, so every auto-detected format is accepted
+//   - The UTC offset is preserved, the location name is not
+//   - Lang is NOT encoded; decoded Dates use EN, exactly like Parse
+//
+// Binary (used by encoding/gob):
+//   - Encodes the instant, the UTC offset and the Lang
+//   - A Date survives a binary round trip including its language setting
+//
+// Calendar settings (week start, weekend and month-end policy) are not encoded
+// in either format; decoded Dates use the defaults.
+
+// binaryVersion is the leading byte of the binary encoding produced by MarshalBinary.
+const binaryVersion byte = 1
+
+// MarshalText implements encoding.TextMarshaler.
+// The date is encoded in RFC 3339 format with nanosecond precision.
+// The Lang is not part of the encoding.
+//
+// Returns an error if the year is outside the range [0, 9999].
+//
+// Example:
+//
+//	date := quando.From(time.Date(2026, 2, 9, 12, 30, 45, 0, time.UTC))
+//	text, _ := date.MarshalText() // "2026-02-09T12:30:45Z"
+func (d Date) MarshalText() ([]byte, error) {
+	b, err := d.t.MarshalText()
+	if err != nil {
+		return nil, fmt.Errorf("marshaling date: %w", err)
+	}
+	return b, nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// The input is parsed with Parse, so all auto-detected formats are accepted.
+// The resulting Date uses EN as its language.
+//
+// Returns an error wrapping ErrInvalidFormat if the input cannot be parsed.
+func (d *Date) UnmarshalText(data []byte) error {
+	parsed, err := Parse(string(data))
+	if err != nil {
+		return fmt.Errorf("unmarshaling date: %w", err)
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// The date is encoded as a JSON string in RFC 3339 format with nanosecond precision.
+// The Lang is not part of the encoding.
+//
+// Example:
+//
+//	type Invoice struct {
+//	    Due quando.Date `json:"due"`
+//	}
+//	// {"due":"2026-02-09T00:00:00Z"}
+func (d Date) MarshalJSON() ([]byte, error) {
+	b, err := d.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(b))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// The input must be a JSON string accepted by Parse. The JSON literal null
+// is a no-op, following the convention of the standard library.
+//
+// Returns an error wrapping ErrInvalidFormat if the input is not a JSON
+// string or cannot be parsed.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("unmarshaling date from JSON %s: %w", data, ErrInvalidFormat)
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// Unlike the text encoding, the binary encoding includes the Lang, so a Date
+// keeps its language through a round trip via encoding/gob.
+//
+// The UTC offset is preserved, the location name is not. The week start,
+// weekend and month-end policy are not encoded.
+func (d Date) MarshalBinary() ([]byte, error) {
+	tb, err := d.t.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshaling date: %w", err)
+	}
+
+	// Layout: version | length of time data | time data | lang
+	b := make([]byte, 0, 2+len(tb)+len(d.lang))
+	b = append(b, binaryVersion, byte(len(tb)))
+	b = append(b, tb...)
+	b = append(b, d.lang...)
+	return b, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It accepts data produced by MarshalBinary.
+//
+// Returns an error wrapping ErrInvalidFormat if the data is malformed.
+func (d *Date) UnmarshalBinary(data []byte) error {
+	if len(data) < 2 {
+		return fmt.Errorf("unmarshaling date: data too short: %w", ErrInvalidFormat)
+	}
+	if data[0] != binaryVersion {
+		return fmt.Errorf("unmarshaling date: unsupported version %d: %w", data[0], ErrInvalidFormat)
+	}
+
+	n := int(data[1])
+	if len(data) < 2+n {
+		return fmt.Errorf("unmarshaling date: data too short: %w", ErrInvalidFormat)
+	}
+
+	var t time.Time
+	if err := t.UnmarshalBinary(data[2 : 2+n]); err != nil {
+		return fmt.Errorf("unmarshaling date: %v: %w", err, ErrInvalidFormat)
+	}
+
+	*d = Date{
+		t:    t,
+		lang: Lang(data[2+n:]),
+	}
+	return nil
+}
@@ -0,0 +1,268 @@
+package quando
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMarshalText(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     Date
+		expected string
+	}{
+		{
+			name:     "UTC",
+			date:     From(time.Date(2026, 2, 9, 12, 30, 45, 0, time.UTC)),
+			expected: "2026-02-09T12:30:45Z",
+		},
+		{
+			name:     "nanoseconds",
+			date:     From(time.Date(2026, 2, 9, 12, 30, 45, 123456789, time.UTC)),
+			expected: "2026-02-09T12:30:45.123456789Z",
+		},
+		{
+			name:     "fixed offset",
+			date:     From(time.Date(2026, 2, 9, 12, 30, 45, 0, time.FixedZone("CET", 3600))),
+			expected: "2026-02-09T12:30:45+01:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := tt.date.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText() unexpected error: %v", err)
+			}
+			if string(b) != tt.expected {
+				t.Errorf("MarshalText() = %q, want %q", b, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMarshalTextOutOfRange(t *testing.T) {
+	date := From(time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC))
+	if _, err := date.MarshalText(); err == nil {
+		t.Error("MarshalText() for year 10000 should return an error")
+	}
+}
+
+func TestUnmarshalText(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected time.Time
+	}{
+		{"RFC3339", "2026-02-09T12:30:45Z", time.Date(2026, 2, 9, 12, 30, 45, 0, time.UTC)},
+		{"ISO", "2026-02-09", time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC)},
+		{"EU", "09.02.2026", time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Date
+			if err := d.UnmarshalText([]byte(tt.input)); err != nil {
+				t.Fatalf("UnmarshalText(%q) unexpected error: %v", tt.input, err)
+			}
+			if !d.Time().Equal(tt.expected) {
+				t.Errorf("UnmarshalText(%q) = %v, want %v", tt.input, d.Time(), tt.expected)
+			}
+			if d.lang != EN {
+				t.Errorf("UnmarshalText(%q) lang = %v, want %v", tt.input, d.lang, EN)
+			}
+		})
+	}
+}
+
+func TestUnmarshalTextError(t *testing.T) {
+	original := From(time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC))
+	d := original
+
+	err := d.UnmarshalText([]byte("01/02/2026"))
+	if !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("UnmarshalText() error = %v, want ErrInvalidFormat", err)
+	}
+	if d != original {
+		t.Errorf("UnmarshalText() modified receiver on error: %v", d)
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	type invoice struct {
+		Number string `json:"number"`
+		Due    Date   `json:"due"`
+	}
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	in := invoice{
+		Number: "2026-001",
+		Due:    From(time.Date(2026, 2, 9, 12, 30, 45, 500, berlin)).WithLang(DE),
+	}
+
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("json.Marshal() unexpected error: %v", err)
+	}
+
+	expected := `{"number":"2026-001","due":"2026-02-09T12:30:45.0000005+01:00"}`
+	if string(b) != expected {
+		t.Errorf("json.Marshal() = %s, want %s", b, expected)
+	}
+
+	var out invoice
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatalf("json.Unmarshal() unexpected error: %v", err)
+	}
+
+	if !out.Due.Time().Equal(in.Due.Time()) {
+		t.Errorf("round trip = %v, want %v", out.Due.Time(), in.Due.Time())
+	}
+
+	// Lang is not part of the JSON encoding
+	if out.Due.lang != EN {
+		t.Errorf("round trip lang = %v, want %v", out.Due.lang, EN)
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Run("null is a no-op", func(t *testing.T) {
+		original := From(time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC))
+		d := original
+		if err := json.Unmarshal([]byte("null"), &d); err != nil {
+			t.Fatalf("json.Unmarshal(null) unexpected error: %v", err)
+		}
+		if d != original {
+			t.Errorf("json.Unmarshal(null) = %v, want %v", d, original)
+		}
+	})
+
+	t.Run("auto-detected format", func(t *testing.T) {
+		var d Date
+		if err := json.Unmarshal([]byte(`"09.02.2026"`), &d); err != nil {
+			t.Fatalf("json.Unmarshal() unexpected error: %v", err)
+		}
+		if d.String() != "2026-02-09 00:00:00" {
+			t.Errorf("json.Unmarshal() = %v, want 2026-02-09 00:00:00", d)
+		}
+	})
+
+	errorTests := []struct {
+		name  string
+		input string
+	}{
+		{"number", "1707480000"},
+		{"invalid date", `"not-a-date"`},
+		{"empty string", `""`},
+		{"ambiguous", `"01/02/2026"`},
+	}
+
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Date
+			err := d.UnmarshalJSON([]byte(tt.input))
+			if !errors.Is(err, ErrInvalidFormat) {
+				t.Errorf("UnmarshalJSON(%s) error = %v, want ErrInvalidFormat", tt.input, err)
+			}
+		})
+	}
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		date Date
+	}{
+		{"UTC with EN", From(time.Date(2026, 2, 9, 12, 30, 45, 123, time.UTC))},
+		{"offset with DE", From(time.Date(2026, 2, 9, 12, 30, 45, 0, time.FixedZone("", 3600))).WithLang(DE)},
+		{"multi-byte lang", From(time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC)).WithLang(ZhTW)},
+		{"empty lang", Date{t: time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := tt.date.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary() unexpected error: %v", err)
+			}
+
+			var d Date
+			if err := d.UnmarshalBinary(b); err != nil {
+				t.Fatalf("UnmarshalBinary() unexpected error: %v", err)
+			}
+
+			if !d.Time().Equal(tt.date.Time()) {
+				t.Errorf("round trip time = %v, want %v", d.Time(), tt.date.Time())
+			}
+			_, gotOffset := d.Time().Zone()
+			_, wantOffset := tt.date.Time().Zone()
+			if gotOffset != wantOffset {
+				t.Errorf("round trip offset = %d, want %d", gotOffset, wantOffset)
+			}
+			if d.lang != tt.date.lang {
+				t.Errorf("round trip lang = %q, want %q", d.lang, tt.date.lang)
+			}
+		})
+	}
+}
+
+func TestUnmarshalBinaryErrors(t *testing.T) {
+	valid, err := From(time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC)).MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"version only", []byte{binaryVersion}},
+		{"unknown version", append([]byte{99}, valid[1:]...)},
+		{"truncated time", valid[:5]},
+		{"corrupt time", []byte{binaryVersion, 2, 0xff, 0xff}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Date
+			err := d.UnmarshalBinary(tt.data)
+			if !errors.Is(err, ErrInvalidFormat) {
+				t.Errorf("UnmarshalBinary() error = %v, want ErrInvalidFormat", err)
+			}
+		})
+	}
+}
+
+func TestGobRoundTrip(t *testing.T) {
+	type record struct {
+		Created Date
+	}
+
+	in := record{Created: From(time.Date(2026, 2, 9, 12, 30, 45, 0, time.UTC)).WithLang(FR)}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("gob Encode() unexpected error: %v", err)
+	}
+
+	var out record
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatalf("gob Decode() unexpected error: %v", err)
+	}
+
+	if !out.Created.Time().Equal(in.Created.Time()) {
+		t.Errorf("gob round trip = %v, want %v", out.Created.Time(), in.Created.Time())
+	}
+	if out.Created.lang != FR {
+		t.Errorf("gob round trip lang = %v, want %v", out.Created.lang, FR)
+	}
+}
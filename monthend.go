@@ -0,0 +1,92 @@
+package quando
+
+import "time"
+
+// MonthEndPolicy determines how Add and Sub handle month-based units (Months,
+// Quarters, HalfYears, Years, Decades and Centuries) when the day of the
+// month does not exist in the target month, or when the date is the last day
+// of its month.
+//
+// The zero value is MonthEndClamp, so Dates without an explicit policy behave
+// as before. Attach a policy to a Date with WithMonthEndPolicy.
+//
+// Example:
+//
+//	jan31 := quando.MustParse("2026-01-31")
+//	jan31.Add(1, quando.Months)                                             // 2026-02-28
+//	jan31.WithMonthEndPolicy(quando.MonthEndOverflow).Add(1, quando.Months) // 2026-03-03
+//
+//	feb28 := quando.MustParse("2026-02-28").WithMonthEndPolicy(quando.MonthEndSticky)
+//	feb28.Add(1, quando.Months) // 2026-03-31
+type MonthEndPolicy uint8
+
+const (
+	// MonthEndClamp snaps to the last day of the target month if the day
+	// does not exist there (default): Jan 31 + 1 month = Feb 28.
+	MonthEndClamp MonthEndPolicy = iota
+
+	// MonthEndOverflow rolls the excess days over into the following month,
+	// like time.Time.AddDate: Jan 31 + 1 month = Mar 3 (Mar 2 in leap years).
+	MonthEndOverflow
+
+	// MonthEndSticky keeps dates on the last day of the month: if the date is
+	// the last day of its month, the result is the last day of the target
+	// month (Feb 28 + 1 month = Mar 31). Other dates are clamped.
+	MonthEndSticky
+)
+
+// String returns the name of the policy, e.g. "clamp".
+func (p MonthEndPolicy) String() string {
+	switch p {
+	case MonthEndClamp:
+		return "clamp"
+	case MonthEndOverflow:
+		return "overflow"
+	case MonthEndSticky:
+		return "sticky"
+	default:
+		return "unknown"
+	}
+}
+
+// WithMonthEndPolicy returns a new Date that uses the given month-end policy
+// for month-based arithmetic.
+//
+// The policy is used by Add, Sub, TryAdd and TrySub, and therefore by
+// Range.Each and Range.Step when set on the start date of the range. The
+// setting is carried over to all dates derived from the result. Unknown
+// policies behave like MonthEndClamp.
+//
+// Example:
+//
+//	// Billing on the last day of each month
+//	start := quando.MustParse("2026-02-28").WithMonthEndPolicy(quando.MonthEndSticky)
+//	next := start.Add(1, quando.Months) // 2026-03-31
+func (d Date) WithMonthEndPolicy(p MonthEndPolicy) Date {
+	d.monthEnd = p
+	return d
+}
+
+// MonthEndPolicy returns the month-end policy of the date.
+// Dates use MonthEndClamp unless configured otherwise with WithMonthEndPolicy.
+func (d Date) MonthEndPolicy() MonthEndPolicy {
+	return d.monthEnd
+}
+
+// addMonths adds months to t according to the policy.
+func addMonths(t time.Time, months int, policy MonthEndPolicy) time.Time {
+	switch policy {
+	case MonthEndOverflow:
+		return t.AddDate(0, months, 0)
+	case MonthEndSticky:
+		if t.Day() == daysInMonth(t) {
+			result := addMonthsWithOverflow(t, months)
+			// Day 0 of a month is the last day of the previous month
+			return time.Date(result.Year(), result.Month()+1, 0,
+				t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+		}
+		return addMonthsWithOverflow(t, months)
+	default:
+		return addMonthsWithOverflow(t, months)
+	}
+}
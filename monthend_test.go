@@ -0,0 +1,130 @@
+package quando
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMonthEndPolicyAdd(t *testing.T) {
+	tests := []struct {
+		name   string
+		date   string
+		policy MonthEndPolicy
+		value  int
+		unit   Unit
+		want   string
+	}{
+		{"clamp", "2026-01-31", MonthEndClamp, 1, Months, "2026-02-28"},
+		{"clamp keeps day", "2026-02-28", MonthEndClamp, 1, Months, "2026-03-28"},
+		{"clamp backwards", "2026-03-31", MonthEndClamp, -1, Months, "2026-02-28"},
+
+		{"overflow", "2026-01-31", MonthEndOverflow, 1, Months, "2026-03-03"},
+		{"overflow leap year", "2024-01-31", MonthEndOverflow, 1, Months, "2024-03-02"},
+		{"overflow day exists", "2026-01-15", MonthEndOverflow, 1, Months, "2026-02-15"},
+		{"overflow backwards", "2026-03-31", MonthEndOverflow, -1, Months, "2026-03-03"},
+		{"overflow leap day plus year", "2024-02-29", MonthEndOverflow, 1, Years, "2025-03-01"},
+		{"overflow quarter", "2026-05-31", MonthEndOverflow, 1, Quarters, "2026-08-31"},
+
+		{"sticky from month end", "2026-02-28", MonthEndSticky, 1, Months, "2026-03-31"},
+		{"sticky 30 to 31", "2026-04-30", MonthEndSticky, 1, Months, "2026-05-31"},
+		{"sticky 31 to 28", "2026-01-31", MonthEndSticky, 1, Months, "2026-02-28"},
+		{"sticky backwards", "2026-02-28", MonthEndSticky, -1, Months, "2026-01-31"},
+		{"sticky not at month end", "2026-02-27", MonthEndSticky, 1, Months, "2026-03-27"},
+		{"sticky clamps other days", "2026-01-30", MonthEndSticky, 1, Months, "2026-02-28"},
+		{"sticky leap day plus year", "2024-02-29", MonthEndSticky, 1, Years, "2025-02-28"},
+		{"sticky year to leap day", "2023-02-28", MonthEndSticky, 1, Years, "2024-02-29"},
+		{"sticky quarter", "2026-06-30", MonthEndSticky, 1, Quarters, "2026-09-30"},
+		{"sticky half-year", "2026-06-30", MonthEndSticky, 1, HalfYears, "2026-12-31"},
+
+		{"days are unaffected", "2026-01-31", MonthEndOverflow, 1, Days, "2026-02-01"},
+		{"unknown policy clamps", "2026-01-31", MonthEndPolicy(99), 1, Months, "2026-02-28"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date := MustParse(tt.date).WithMonthEndPolicy(tt.policy)
+			got := date.Add(tt.value, tt.unit)
+			if s := got.Format(ISO); s != tt.want {
+				t.Errorf("Add(%d, %v) with %v = %s, want %s", tt.value, tt.unit, tt.policy, s, tt.want)
+			}
+			if s := date.Sub(-tt.value, tt.unit).Format(ISO); s != tt.want {
+				t.Errorf("Sub(%d, %v) with %v = %s, want %s", -tt.value, tt.unit, tt.policy, s, tt.want)
+			}
+			if got.MonthEndPolicy() != tt.policy {
+				t.Errorf("MonthEndPolicy() = %v, want %v (carried over)", got.MonthEndPolicy(), tt.policy)
+			}
+		})
+	}
+}
+
+func TestMonthEndPolicyPreservesTime(t *testing.T) {
+	date := From(time.Date(2026, 2, 28, 15, 30, 45, 123, time.UTC)).WithMonthEndPolicy(MonthEndSticky)
+	want := time.Date(2026, 3, 31, 15, 30, 45, 123, time.UTC)
+	if got := date.Add(1, Months).Time(); !got.Equal(want) {
+		t.Errorf("Add(1, Months) = %v, want %v", got, want)
+	}
+}
+
+func TestMonthEndPolicyDefault(t *testing.T) {
+	date := MustParse("2026-01-31")
+	if got := date.MonthEndPolicy(); got != MonthEndClamp {
+		t.Errorf("MonthEndPolicy() = %v, want %v", got, MonthEndClamp)
+	}
+	if got := date.WithLang(DE).StartOf(Months).MonthEndPolicy(); got != MonthEndClamp {
+		t.Errorf("derived MonthEndPolicy() = %v, want %v", got, MonthEndClamp)
+	}
+}
+
+func TestMonthEndPolicyRange(t *testing.T) {
+	tests := []struct {
+		name   string
+		start  string
+		policy MonthEndPolicy
+		want   []string
+	}{
+		{"clamp", "2026-01-31", MonthEndClamp, []string{"2026-01-31", "2026-02-28", "2026-03-31", "2026-04-30"}},
+		{"overflow", "2026-01-31", MonthEndOverflow, []string{"2026-01-31", "2026-03-03", "2026-03-31", "2026-05-01"}},
+		{"sticky", "2026-02-28", MonthEndSticky, []string{"2026-02-28", "2026-03-31", "2026-04-30", "2026-05-31"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := MustParse(tt.start).WithMonthEndPolicy(tt.policy)
+			r := NewInclusiveRange(start, MustParse("2026-05-31"))
+
+			var got []string
+			for d := range r.Each(Months) {
+				got = append(got, d.Format(ISO))
+				if len(got) == len(tt.want) {
+					break
+				}
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Each(Months) = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Each(Months)[%d] = %s, want %s", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestMonthEndPolicyString(t *testing.T) {
+	tests := []struct {
+		policy MonthEndPolicy
+		want   string
+	}{
+		{MonthEndClamp, "clamp"},
+		{MonthEndOverflow, "overflow"},
+		{MonthEndSticky, "sticky"},
+		{MonthEndPolicy(99), "unknown"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.policy.String(); got != tt.want {
+			t.Errorf("MonthEndPolicy(%d).String() = %q, want %q", tt.policy, got, tt.want)
+		}
+	}
+}
@@ -13,6 +13,8 @@ import (
 //   - ISO with slash: "2026/02/09" (YYYY/MM/DD)
 //   - EU format: "09.02.2026" (DD.MM.YYYY)
 //   - RFC2822: "Mon, 09 Feb 2026 00:00:00 +0000"
+//   - RFC3339: "2026-02-09T12:30:45Z", "2026-02-09T12:30:45.5+01:00"
+//   - SQL datetime: "2026-02-09 12:30:45", "2026-02-09 12:30:45.5+01:00", "2026-02-09 12:30:45+01"
 //
 // Ambiguous format detection:
 //
@@ -99,6 +101,28 @@ func Parse(s string) (Date, error) {
 				return strings.Contains(s, ",") && len(s) > 20
 			},
 		},
+		// RFC3339 with optional fractional seconds (as produced by MarshalText)
+		{
+			layout: time.RFC3339Nano,
+			validator: func(s string) bool {
+				return len(s) >= 20 && s[4] == '-' && s[7] == '-' && s[10] == 'T'
+			},
+		},
+		// SQL datetime without timezone (also the output of Date.String)
+		{
+			layout:    "2006-01-02 15:04:05.999999999",
+			validator: isSQLDateTime,
+		},
+		// SQL datetime with offset (SQLite drivers)
+		{
+			layout:    "2006-01-02 15:04:05.999999999Z07:00",
+			validator: isSQLDateTime,
+		},
+		// SQL datetime with hour-only offset (PostgreSQL text format)
+		{
+			layout:    "2006-01-02 15:04:05.999999999Z07",
+			validator: isSQLDateTime,
+		},
 	}
 
 	var lastErr error
@@ -141,6 +165,8 @@ func Parse(s string) (Date, error) {
 //   - ISO with slash: "2026/02/09" (YYYY/MM/DD)
 //   - EU format: "09.02.2026" (DD.MM.YYYY)
 //   - RFC2822: "Mon, 09 Feb 2026 00:00:00 +0000"
+//   - RFC3339: "2026-02-09T12:30:45Z"
+//   - SQL datetime: "2026-02-09 12:30:45"
 //
 // Use cases:
 //   - Test fixtures: date := quando.MustParse("2026-02-09")
@@ -191,6 +217,11 @@ func isYearPrefix(s string) bool {
 	return true
 }
 
+// isSQLDateTime checks for the "YYYY-MM-DD HH:MM:SS" prefix used by SQL databases.
+func isSQLDateTime(s string) bool {
+	return len(s) >= 19 && s[4] == '-' && s[7] == '-' && s[10] == ' ' && s[13] == ':' && s[16] == ':'
+}
+
 // ParseWithLayout parses a date string using an explicit Go layout format.
 // This is useful for disambiguating ambiguous formats or parsing custom formats
 // that cannot be automatically detected.
@@ -257,7 +288,10 @@ func ParseWithLayout(s, layout string) (Date, error) {
 //   - week, weeks
 //   - month, months
 //   - quarter, quarters
+//   - half-year, half-years (also halfyear, halfyears)
 //   - year, years
+//   - decade, decades
+//   - century, centuries
 //
 // Examples:
 //
@@ -353,7 +387,8 @@ func ParseRelativeWithClock(s string, clock Clock) (Date, error) {
 }
 
 // parseUnitString maps unit name strings to Unit constants.
-// Supports both singular and plural forms, case-insensitive.
+// Supports both singular and plural forms, case-insensitive. Only units of a
+// day or longer are accepted, since relative dates are always at midnight.
 func parseUnitString(s string) (Unit, error) {
 	switch s {
 	case "day", "days":
@@ -364,9 +399,15 @@ func parseUnitString(s string) (Unit, error) {
 		return Months, nil
 	case "quarter", "quarters":
 		return Quarters, nil
+	case "half-year", "half-years", "halfyear", "halfyears":
+		return HalfYears, nil
 	case "year", "years":
 		return Years, nil
+	case "decade", "decades":
+		return Decades, nil
+	case "century", "centuries":
+		return Centuries, nil
 	default:
-		return 0, fmt.Errorf("unknown unit %q (supported: day, week, month, quarter, year): %w", s, ErrInvalidFormat)
+		return 0, fmt.Errorf("unknown unit %q (supported: day, week, month, quarter, half-year, year, decade, century): %w", s, ErrInvalidFormat)
 	}
 }
@@ -32,6 +32,17 @@ func TestParse(t *testing.T) {
 		{"EU: year start", "01.01.2020", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
 		{"EU: leap year", "29.02.2024", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
 		{"EU: month boundary", "30.06.2026", time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC)},
+
+		// RFC3339 format
+		{"RFC3339: UTC", "2026-02-09T12:30:45Z", time.Date(2026, 2, 9, 12, 30, 45, 0, time.UTC)},
+		{"RFC3339: offset", "2026-02-09T12:30:45+01:00", time.Date(2026, 2, 9, 11, 30, 45, 0, time.UTC)},
+		{"RFC3339: fractional seconds", "2026-02-09T12:30:45.123456789Z", time.Date(2026, 2, 9, 12, 30, 45, 123456789, time.UTC)},
+
+		// SQL datetime format
+		{"SQL: no timezone", "2026-02-09 12:30:45", time.Date(2026, 2, 9, 12, 30, 45, 0, time.UTC)},
+		{"SQL: fractional seconds", "2026-02-09 12:30:45.5", time.Date(2026, 2, 9, 12, 30, 45, 500000000, time.UTC)},
+		{"SQL: offset", "2026-02-09 12:30:45+01:00", time.Date(2026, 2, 9, 11, 30, 45, 0, time.UTC)},
+		{"SQL: hour offset", "2026-02-09 12:30:45.123456+01", time.Date(2026, 2, 9, 11, 30, 45, 123456000, time.UTC)},
 	}
 
 	for _, tt := range tests {
@@ -666,6 +677,36 @@ func TestParseRelativeWithClock(t *testing.T) {
 			expectedMonth: time.March,
 			expectedDay:   15,
 		},
+
+		// Half-years, decades and centuries
+		{
+			name:          "+1 half-year",
+			input:         "+1 half-year",
+			expectedYear:  2026,
+			expectedMonth: time.August,
+			expectedDay:   15,
+		},
+		{
+			name:          "-3 halfyears",
+			input:         "-3 halfyears",
+			expectedYear:  2024,
+			expectedMonth: time.August,
+			expectedDay:   15,
+		},
+		{
+			name:          "+1 decade",
+			input:         "+1 decade",
+			expectedYear:  2036,
+			expectedMonth: time.February,
+			expectedDay:   15,
+		},
+		{
+			name:          "-2 centuries",
+			input:         "-2 centuries",
+			expectedYear:  1826,
+			expectedMonth: time.February,
+			expectedDay:   15,
+		},
 	}
 
 	for _, tt := range tests {
@@ -716,7 +757,7 @@ func TestParseRelativeErrors(t *testing.T) {
 		{"invalid offset - not a number", "+abc days"},
 		{"invalid offset - float", "+1.5 days"},
 		{"invalid unit", "+2 fortnights"},
-		{"invalid unit 2", "+1 decade"},
+		{"invalid unit 2", "+1 millennium"},
 		{"invalid unit 3", "+3 hours"}, // hours not supported in Phase 1
 		{"sign without number", "+ days"},
 		{"number without unit", "+2"},
@@ -0,0 +1,299 @@
+package quando
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Period is an amount of calendar and clock time made up of several units,
+// such as "1 year, 2 months and 3 days". Unlike time.Duration, a Period does
+// not have a fixed length: one month added to January 31 covers fewer days
+// than one month added to March 1.
+//
+// Periods can be written and parsed as ISO 8601 durations (e.g. "P1Y2M3DT4H")
+// and implement encoding.TextMarshaler and encoding.TextUnmarshaler, so they
+// can be stored in configuration files and JSON.
+//
+// The zero value is an empty period.
+//
+// Example:
+//
+//	p := quando.Period{Years: 1, Months: 2, Days: 3}
+//	date := quando.MustParse("2026-01-31").AddPeriod(p) // 2027-04-03
+//	p.String()                                          // "P1Y2M3D"
+type Period struct {
+	Years   int
+	Months  int
+	Weeks   int
+	Days    int
+	Hours   int
+	Minutes int
+	Seconds int
+}
+
+// ParsePeriod parses an ISO 8601 duration of the form "PnYnMnWnDTnHnMnS",
+// for example "P1Y2M3DT4H" or "PT90M". Components may be omitted but must
+// appear in this order, and at least one component is required.
+//
+// As extensions to ISO 8601, designators are case-insensitive, the whole
+// period may be preceded by a sign ("-P1D"), and components may carry their
+// own sign ("P1M-3D"). Fractional values are not supported.
+//
+// Returns an error wrapping ErrInvalidFormat if the input is not a valid
+// duration.
+//
+// Example:
+//
+//	p, err := quando.ParsePeriod("P1Y2M3DT4H")
+//	if err != nil {
+//	    return err
+//	}
+//	// p: Period{Years: 1, Months: 2, Days: 3, Hours: 4}
+func ParsePeriod(s string) (Period, error) {
+	input := strings.ToUpper(strings.TrimSpace(s))
+	if input == "" {
+		return Period{}, fmt.Errorf("parsing period %q: empty string: %w", s, ErrInvalidFormat)
+	}
+
+	negative := false
+	if input[0] == '-' || input[0] == '+' {
+		negative = input[0] == '-'
+		input = input[1:]
+	}
+	rest, ok := strings.CutPrefix(input, "P")
+	if !ok {
+		return Period{}, fmt.Errorf("parsing period %q: must start with \"P\": %w", s, ErrInvalidFormat)
+	}
+
+	var p Period
+	components := 0
+	inTime := false
+	designators := "YMWD" // designators still allowed, in order
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime || len(rest) == 1 {
+				return Period{}, fmt.Errorf("parsing period %q: misplaced \"T\": %w", s, ErrInvalidFormat)
+			}
+			inTime = true
+			designators = "HMS"
+			rest = rest[1:]
+			continue
+		}
+
+		end := strings.IndexFunc(rest, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '-' && r != '+'
+		})
+		switch {
+		case end < 0:
+			return Period{}, fmt.Errorf("parsing period %q: missing designator after %q: %w", s, rest, ErrInvalidFormat)
+		case end == 0:
+			return Period{}, fmt.Errorf("parsing period %q: expected a number at %q: %w", s, rest, ErrInvalidFormat)
+		case rest[end] == '.' || rest[end] == ',':
+			return Period{}, fmt.Errorf("parsing period %q: fractional values are not supported: %w", s, ErrInvalidFormat)
+		}
+		value, err := strconv.Atoi(rest[:end])
+		if err != nil {
+			return Period{}, fmt.Errorf("parsing period %q: invalid number %q: %w", s, rest[:end], ErrInvalidFormat)
+		}
+
+		// Rejects unknown, repeated and out-of-order designators alike
+		i := strings.IndexByte(designators, rest[end])
+		if i < 0 {
+			return Period{}, fmt.Errorf("parsing period %q: unexpected %q: %w", s, rest[end:], ErrInvalidFormat)
+		}
+		switch designators[i] {
+		case 'Y':
+			p.Years = value
+		case 'M':
+			if inTime {
+				p.Minutes = value
+			} else {
+				p.Months = value
+			}
+		case 'W':
+			p.Weeks = value
+		case 'D':
+			p.Days = value
+		case 'H':
+			p.Hours = value
+		case 'S':
+			p.Seconds = value
+		}
+		designators = designators[i+1:]
+		rest = rest[end+1:]
+		components++
+	}
+
+	if components == 0 {
+		return Period{}, fmt.Errorf("parsing period %q: no components: %w", s, ErrInvalidFormat)
+	}
+	if negative {
+		p = p.Negate()
+	}
+	return p, nil
+}
+
+// MustParsePeriod is like ParsePeriod but panics if the period cannot be
+// parsed. It simplifies safe initialization of global variables.
+//
+// Example:
+//
+//	var retention = quando.MustParsePeriod("P90D")
+func MustParsePeriod(s string) Period {
+	p, err := ParsePeriod(s)
+	if err != nil {
+		panic(fmt.Sprintf("quando.MustParsePeriod(%q): %v", s, err))
+	}
+	return p
+}
+
+// String returns the period as an ISO 8601 duration, e.g. "P1Y2M3DT4H".
+// Zero components are omitted, and the zero period is "P0D".
+//
+// If no component is positive, the period is written with a leading minus
+// sign ("-P1Y2M"). Otherwise negative components carry their own sign
+// ("P1M-3D"). Both forms are accepted by ParsePeriod.
+func (p Period) String() string {
+	if p.IsZero() {
+		return "P0D"
+	}
+
+	var b strings.Builder
+	if p.Years <= 0 && p.Months <= 0 && p.Weeks <= 0 && p.Days <= 0 &&
+		p.Hours <= 0 && p.Minutes <= 0 && p.Seconds <= 0 {
+		b.WriteByte('-')
+		p = p.Negate()
+	}
+
+	write := func(value int, designator byte) {
+		if value != 0 {
+			b.WriteString(strconv.Itoa(value))
+			b.WriteByte(designator)
+		}
+	}
+
+	b.WriteByte('P')
+	write(p.Years, 'Y')
+	write(p.Months, 'M')
+	write(p.Weeks, 'W')
+	write(p.Days, 'D')
+	if p.Hours != 0 || p.Minutes != 0 || p.Seconds != 0 {
+		b.WriteByte('T')
+		write(p.Hours, 'H')
+		write(p.Minutes, 'M')
+		write(p.Seconds, 'S')
+	}
+	return b.String()
+}
+
+// IsZero reports whether all components of the period are zero.
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+// Negate returns the period with all components negated.
+//
+// Example:
+//
+//	quando.Period{Months: 1, Days: -3}.Negate() // Period{Months: -1, Days: 3}
+func (p Period) Negate() Period {
+	return Period{
+		Years:   -p.Years,
+		Months:  -p.Months,
+		Weeks:   -p.Weeks,
+		Days:    -p.Days,
+		Hours:   -p.Hours,
+		Minutes: -p.Minutes,
+		Seconds: -p.Seconds,
+	}
+}
+
+// Normalize returns the period with overflowing components carried into the
+// next larger unit: 12 months into a year, 7 days into a week, 60 seconds
+// into a minute and 60 minutes into an hour.
+//
+// Only conversions that hold for every date are made. Hours are never carried
+// into days (a day can have 23 or 25 hours across DST transitions), and days
+// are never carried into months. Within each group (years and months, weeks
+// and days, hours to seconds), all components end up with the same sign.
+//
+// Example:
+//
+//	quando.Period{Months: 14, Days: 10, Minutes: 90}.Normalize()
+//	// Period{Years: 1, Months: 2, Weeks: 1, Days: 3, Hours: 1, Minutes: 30}
+func (p Period) Normalize() Period {
+	months := p.Years*12 + p.Months
+	days := p.Weeks*7 + p.Days
+	seconds := p.Hours*3600 + p.Minutes*60 + p.Seconds
+
+	return Period{
+		Years:   months / 12,
+		Months:  months % 12,
+		Weeks:   days / 7,
+		Days:    days % 7,
+		Hours:   seconds / 3600,
+		Minutes: seconds % 3600 / 60,
+		Seconds: seconds % 60,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// The period is encoded as an ISO 8601 duration (see String).
+func (p Period) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// The input is parsed with ParsePeriod.
+//
+// Returns an error wrapping ErrInvalidFormat if the input cannot be parsed.
+func (p *Period) UnmarshalText(data []byte) error {
+	parsed, err := ParsePeriod(string(data))
+	if err != nil {
+		return fmt.Errorf("unmarshaling period: %w", err)
+	}
+	*p = parsed
+	return nil
+}
+
+// AddPeriod adds the period to the date and returns a new Date.
+//
+// The components are applied from the largest to the smallest unit, in three
+// steps:
+//  1. Years and months together, as Add(12*Years+Months, Months), including
+//     month-end handling (see WithMonthEndPolicy)
+//  2. Weeks and days together, as calendar days (DST-safe, as with Add)
+//  3. Hours, minutes and seconds, as elapsed time
+//
+// Adding years and months in one step means that Jan 31 + "P1M1D" is Mar 1
+// (Feb 28 + 1 day), and that the result does not depend on how the months
+// are split between Years and Months.
+//
+// Example:
+//
+//	date := quando.MustParse("2026-01-31")
+//	date.AddPeriod(quando.MustParsePeriod("P1M1D"))  // 2026-03-01
+//	date.AddPeriod(quando.MustParsePeriod("P1Y2M3D")) // 2027-04-03
+func (d Date) AddPeriod(p Period) Date {
+	result := d.Add(p.Years*12+p.Months, Months)
+	result = result.Add(p.Weeks*7+p.Days, Days)
+
+	elapsed := time.Duration(p.Hours)*time.Hour +
+		time.Duration(p.Minutes)*time.Minute +
+		time.Duration(p.Seconds)*time.Second
+	return result.with(result.t.Add(elapsed))
+}
+
+// SubPeriod subtracts the period from the date and returns a new Date.
+// This is equivalent to AddPeriod with the negated period, so the components
+// are applied in the same order.
+//
+// Example:
+//
+//	date := quando.MustParse("2026-03-31")
+//	date.SubPeriod(quando.Period{Months: 1, Days: 1}) // 2026-02-27
+func (d Date) SubPeriod(p Period) Date {
+	return d.AddPeriod(p.Negate())
+}
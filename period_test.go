@@ -0,0 +1,260 @@
+package quando
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParsePeriod(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Period
+	}{
+		{"P1Y2M3DT4H5M6S", Period{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 5, Seconds: 6}},
+		{"P1Y2M3DT4H", Period{Years: 1, Months: 2, Days: 3, Hours: 4}},
+		{"P2W", Period{Weeks: 2}},
+		{"P1W3D", Period{Weeks: 1, Days: 3}},
+		{"PT90M", Period{Minutes: 90}},
+		{"P1M", Period{Months: 1}},
+		{"PT1M", Period{Minutes: 1}},
+		{"P0D", Period{}},
+		{"-P1Y2M", Period{Years: -1, Months: -2}},
+		{"+P1D", Period{Days: 1}},
+		{"P1M-3D", Period{Months: 1, Days: -3}},
+		{"-P1M-3D", Period{Months: -1, Days: 3}},
+		{"p1y2mt3h", Period{Years: 1, Months: 2, Hours: 3}},
+		{"  P1D  ", Period{Days: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParsePeriod(tt.input)
+			if err != nil {
+				t.Fatalf("ParsePeriod(%q) error = %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParsePeriod(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePeriod_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"P",
+		"PT",
+		"P1DT",
+		"1Y",
+		"P1",
+		"PY",
+		"P1X",
+		"P1D2M",
+		"P1Y1Y",
+		"PT1H2D",
+		"P1H",
+		"P1DTT1H",
+		"PT1HT1M",
+		"P1.5D",
+		"PT0,5S",
+		"P--1D",
+		"P99999999999999999999D",
+		"P 1D",
+	}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParsePeriod(input)
+			if !errors.Is(err, ErrInvalidFormat) {
+				t.Errorf("ParsePeriod(%q) error = %v, want ErrInvalidFormat", input, err)
+			}
+		})
+	}
+}
+
+func TestMustParsePeriod(t *testing.T) {
+	if got := MustParsePeriod("P1D"); got != (Period{Days: 1}) {
+		t.Errorf("MustParsePeriod(\"P1D\") = %+v", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParsePeriod with invalid input did not panic")
+		}
+	}()
+	MustParsePeriod("1D")
+}
+
+func TestPeriodString(t *testing.T) {
+	tests := []struct {
+		period Period
+		want   string
+	}{
+		{Period{}, "P0D"},
+		{Period{Years: 1, Months: 2, Days: 3, Hours: 4}, "P1Y2M3DT4H"},
+		{Period{Weeks: 2}, "P2W"},
+		{Period{Minutes: 90}, "PT90M"},
+		{Period{Seconds: 5}, "PT5S"},
+		{Period{Years: -1, Months: -2}, "-P1Y2M"},
+		{Period{Months: 1, Days: -3}, "P1M-3D"},
+		{Period{Days: 1, Hours: -1}, "P1DT-1H"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.period.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+			parsed, err := ParsePeriod(tt.want)
+			if err != nil {
+				t.Fatalf("ParsePeriod(%q) error = %v", tt.want, err)
+			}
+			if parsed != tt.period {
+				t.Errorf("ParsePeriod(%q) = %+v, want %+v (round trip)", tt.want, parsed, tt.period)
+			}
+		})
+	}
+}
+
+func TestPeriodNegate(t *testing.T) {
+	p := Period{Years: 1, Months: -2, Weeks: 3, Days: -4, Hours: 5, Minutes: -6, Seconds: 7}
+	want := Period{Years: -1, Months: 2, Weeks: -3, Days: 4, Hours: -5, Minutes: 6, Seconds: -7}
+	if got := p.Negate(); got != want {
+		t.Errorf("Negate() = %+v, want %+v", got, want)
+	}
+	if got := p.Negate().Negate(); got != p {
+		t.Errorf("Negate().Negate() = %+v, want %+v", got, p)
+	}
+}
+
+func TestPeriodNormalize(t *testing.T) {
+	tests := []struct {
+		name   string
+		period Period
+		want   Period
+	}{
+		{"zero", Period{}, Period{}},
+		{"already normal", Period{Years: 1, Months: 2, Days: 3}, Period{Years: 1, Months: 2, Days: 3}},
+		{"months into years", Period{Months: 14}, Period{Years: 1, Months: 2}},
+		{"days into weeks", Period{Days: 10}, Period{Weeks: 1, Days: 3}},
+		{"seconds into hours", Period{Seconds: 3725}, Period{Hours: 1, Minutes: 2, Seconds: 5}},
+		{"minutes into hours", Period{Minutes: 90}, Period{Hours: 1, Minutes: 30}},
+		{"hours stay hours", Period{Hours: 49}, Period{Hours: 49}},
+		{"mixed signs in group", Period{Years: 1, Months: -1}, Period{Months: 11}},
+		{"negative", Period{Months: -14, Seconds: -61}, Period{Years: -1, Months: -2, Minutes: -1, Seconds: -1}},
+		{"groups keep own sign", Period{Months: 1, Days: -3}, Period{Months: 1, Days: -3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.Normalize(); got != tt.want {
+				t.Errorf("Normalize() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriodIsZero(t *testing.T) {
+	if !(Period{}).IsZero() {
+		t.Error("Period{}.IsZero() = false, want true")
+	}
+	if (Period{Seconds: 1}).IsZero() {
+		t.Error("Period{Seconds: 1}.IsZero() = true, want false")
+	}
+}
+
+func TestPeriodText(t *testing.T) {
+	type config struct {
+		Retention Period `json:"retention"`
+	}
+
+	data, err := json.Marshal(config{Retention: Period{Years: 1, Days: 3}})
+	if err != nil {
+		t.Fatalf("json.Marshal error = %v", err)
+	}
+	if got, want := string(data), `{"retention":"P1Y3D"}`; got != want {
+		t.Errorf("json.Marshal = %s, want %s", got, want)
+	}
+
+	var c config
+	if err := json.Unmarshal([]byte(`{"retention":"PT36H"}`), &c); err != nil {
+		t.Fatalf("json.Unmarshal error = %v", err)
+	}
+	if want := (Period{Hours: 36}); c.Retention != want {
+		t.Errorf("json.Unmarshal = %+v, want %+v", c.Retention, want)
+	}
+
+	var p Period
+	if err := p.UnmarshalText([]byte("36 hours")); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("UnmarshalText error = %v, want ErrInvalidFormat", err)
+	}
+}
+
+func TestAddPeriod(t *testing.T) {
+	base := From(time.Date(2026, 1, 31, 12, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name   string
+		period Period
+		want   string
+	}{
+		{"zero", Period{}, "2026-01-31 12:00:00"},
+		{"years months days", Period{Years: 1, Months: 2, Days: 3}, "2027-04-03 12:00:00"},
+		{"months before days", Period{Months: 1, Days: 1}, "2026-03-01 12:00:00"},
+		{"years and months together", Period{Years: 1, Months: 1}, "2027-02-28 12:00:00"},
+		{"weeks and days", Period{Weeks: 1, Days: 2}, "2026-02-09 12:00:00"},
+		{"time", Period{Hours: 13, Minutes: 30, Seconds: 15}, "2026-02-01 01:30:15"},
+		{"negative", Period{Months: -1, Days: -1}, "2025-12-30 12:00:00"},
+		{"mixed signs", Period{Months: 1, Hours: -12}, "2026-02-28 00:00:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := base.AddPeriod(tt.period).String(); got != tt.want {
+				t.Errorf("AddPeriod(%v) = %s, want %s", tt.period, got, tt.want)
+			}
+			if got := base.SubPeriod(tt.period.Negate()).String(); got != tt.want {
+				t.Errorf("SubPeriod(%v) = %s, want %s", tt.period.Negate(), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddPeriod_MatchesChainedAdd(t *testing.T) {
+	base := From(time.Date(2024, 2, 29, 8, 15, 0, 0, time.UTC))
+	p := Period{Years: 2, Months: 3, Weeks: 1, Days: 4, Hours: 5, Minutes: 6, Seconds: 7}
+
+	want := base.Add(27, Months).Add(11, Days).Add(5, Hours).Add(6, Minutes).Add(7, Seconds)
+	if got := base.AddPeriod(p); !got.Equal(want) {
+		t.Errorf("AddPeriod(%v) = %v, want %v", p, got, want)
+	}
+}
+
+func TestAddPeriod_Settings(t *testing.T) {
+	date := MustParse("2026-02-28").WithLang(DE).WithMonthEndPolicy(MonthEndSticky)
+	got := date.AddPeriod(Period{Months: 1})
+	if got.String() != "2026-03-31 00:00:00" {
+		t.Errorf("AddPeriod with MonthEndSticky = %v, want 2026-03-31 00:00:00", got)
+	}
+	if got.lang != DE {
+		t.Errorf("AddPeriod lang = %v, want %v", got.lang, DE)
+	}
+}
+
+func TestAddPeriod_DST(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data not available: %v", err)
+	}
+
+	// Spring forward on 2026-03-29: days keep the wall clock, hours are elapsed time
+	date := From(time.Date(2026, 3, 28, 12, 0, 0, 0, loc))
+	if got := date.AddPeriod(Period{Days: 1}).Time(); got.Hour() != 12 {
+		t.Errorf("AddPeriod(P1D) = %v, want 12:00 local time", got)
+	}
+	if got := date.AddPeriod(Period{Hours: 24}).Time(); got.Hour() != 13 {
+		t.Errorf("AddPeriod(PT24H) = %v, want 13:00 local time", got)
+	}
+}
@@ -0,0 +1,174 @@
+package quando
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Range is a span of time between two dates. The start is always included;
+// whether the end is included depends on how the range was created
+// (see NewRange and NewInclusiveRange).
+//
+// A range whose end lies before its start (or, for half-open ranges, at its
+// start) is empty: it contains no dates and its iterators yield nothing.
+//
+// Range is a value type and safe for concurrent use, like Date.
+type Range struct {
+	start     Date
+	end       Date
+	inclusive bool
+}
+
+// NewRange returns the half-open range [start, end): start is included,
+// end is not. Half-open ranges can be chained without overlap, e.g. one
+// range per month from the first of the month to the first of the next.
+//
+// Example:
+//
+//	january := quando.NewRange(
+//	    quando.MustParse("2026-01-01"),
+//	    quando.MustParse("2026-02-01"),
+//	)
+//	january.Contains(quando.MustParse("2026-02-01")) // false
+func NewRange(start, end Date) Range {
+	return Range{start: start, end: end}
+}
+
+// NewInclusiveRange returns the closed range [start, end]: both start and end
+// are included.
+//
+// Example:
+//
+//	vacation := quando.NewInclusiveRange(
+//	    quando.MustParse("2026-07-06"),
+//	    quando.MustParse("2026-07-17"),
+//	)
+//	vacation.Contains(quando.MustParse("2026-07-17")) // true
+func NewInclusiveRange(start, end Date) Range {
+	return Range{start: start, end: end, inclusive: true}
+}
+
+// Start returns the start of the range.
+func (r Range) Start() Date {
+	return r.start
+}
+
+// End returns the end of the range. Use IsInclusive to find out whether the
+// end itself belongs to the range.
+func (r Range) End() Date {
+	return r.end
+}
+
+// IsInclusive reports whether the end of the range is included.
+func (r Range) IsInclusive() bool {
+	return r.inclusive
+}
+
+// IsEmpty reports whether the range contains no instant at all.
+// A half-open range is empty if end is not after start; an inclusive range
+// is empty if end is before start.
+func (r Range) IsEmpty() bool {
+	if r.inclusive {
+		return r.end.t.Before(r.start.t)
+	}
+	return !r.end.t.After(r.start.t)
+}
+
+// Contains reports whether d lies within the range. Instants are compared,
+// so dates in different time zones are handled correctly.
+//
+// Example:
+//
+//	r := quando.NewRange(quando.MustParse("2026-01-01"), quando.MustParse("2026-02-01"))
+//	r.Contains(quando.MustParse("2026-01-15")) // true
+func (r Range) Contains(d Date) bool {
+	if d.t.Before(r.start.t) {
+		return false
+	}
+	return r.beforeEnd(d)
+}
+
+// Duration returns the duration from the start to the end of the range.
+// For an empty range with end before start, the duration is negative.
+// The start date's weekend carries over, so BusinessDays honors it.
+//
+// Example:
+//
+//	r := quando.NewRange(quando.MustParse("2026-01-01"), quando.MustParse("2026-02-01"))
+//	r.Duration().Days() // 31
+func (r Range) Duration() Duration {
+	return Diff(r.start.t, r.end.t).WithWeekend(r.start.weekend)
+}
+
+// Each returns an iterator over the dates in the range, starting at the start
+// date and advancing by one unit at a time. It is equivalent to Step(1, unit).
+//
+// Example:
+//
+//	r := quando.NewRange(quando.MustParse("2026-01-31"), quando.MustParse("2026-05-01"))
+//	for d := range r.Each(quando.Months) {
+//	    fmt.Println(d.Format(quando.ISO)) // 2026-01-31, 2026-02-28, 2026-03-31, 2026-04-30
+//	}
+func (r Range) Each(unit Unit) iter.Seq[Date] {
+	return r.Step(1, unit)
+}
+
+// Step returns an iterator over the dates in the range, starting at the start
+// date and advancing by n units at a time.
+//
+// The i-th date is computed as start.Add(i*n, unit), always relative to the
+// start date, so month-end clamping does not accumulate: stepping monthly from
+// January 31 yields February 28, March 31, April 30 and so on. Days are
+// calendar days, so the time of day is kept across DST transitions.
+//
+// Iteration stops at the first date outside the range. If n is not positive
+// or the range is empty, the iterator yields nothing.
+//
+// Example:
+//
+//	r := quando.NewInclusiveRange(quando.MustParse("2026-01-05"), quando.MustParse("2026-03-30"))
+//	for d := range r.Step(2, quando.Weeks) {
+//	    fmt.Println(d.Format(quando.ISO)) // every other Monday
+//	}
+func (r Range) Step(n int, unit Unit) iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		if n <= 0 || r.IsEmpty() {
+			return
+		}
+
+		prev := r.start
+		if !yield(prev) {
+			return
+		}
+		for i := 1; ; i++ {
+			next := r.start.Add(i*n, unit)
+			// Guard against units that do not advance the date
+			if !next.t.After(prev.t) || !r.beforeEnd(next) {
+				return
+			}
+			if !yield(next) {
+				return
+			}
+			prev = next
+		}
+	}
+}
+
+// String returns the range in interval notation, e.g.
+// "[2026-01-01 00:00:00, 2026-02-01 00:00:00)" for a half-open range.
+func (r Range) String() string {
+	closing := ")"
+	if r.inclusive {
+		closing = "]"
+	}
+	return fmt.Sprintf("[%s, %s%s", r.start, r.end, closing)
+}
+
+// beforeEnd reports whether d lies before the end of the range, taking the
+// inclusiveness of the end into account.
+func (r Range) beforeEnd(d Date) bool {
+	if r.inclusive {
+		return !d.t.After(r.end.t)
+	}
+	return d.t.Before(r.end.t)
+}
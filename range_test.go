@@ -0,0 +1,291 @@
+package quando
+
+import (
+	"iter"
+	"slices"
+	"testing"
+	"time"
+)
+
+// formatAll collects the dates of an iterator in ISO format
+func formatAll(seq iter.Seq[Date]) []string {
+	var result []string
+	for d := range seq {
+		result = append(result, d.Format(ISO))
+	}
+	return result
+}
+
+func TestNewRange(t *testing.T) {
+	start := MustParse("2026-01-01")
+	end := MustParse("2026-02-01")
+
+	r := NewRange(start, end)
+	if r.Start() != start || r.End() != end {
+		t.Errorf("NewRange() = %v, want [%v, %v)", r, start, end)
+	}
+	if r.IsInclusive() {
+		t.Error("NewRange().IsInclusive() = true, want false")
+	}
+
+	inclusive := NewInclusiveRange(start, end)
+	if !inclusive.IsInclusive() {
+		t.Error("NewInclusiveRange().IsInclusive() = false, want true")
+	}
+}
+
+func TestRangeIsEmpty(t *testing.T) {
+	a := MustParse("2026-01-01")
+	b := MustParse("2026-02-01")
+
+	tests := []struct {
+		name     string
+		r        Range
+		expected bool
+	}{
+		{"half-open", NewRange(a, b), false},
+		{"half-open single instant", NewRange(a, a), true},
+		{"half-open reversed", NewRange(b, a), true},
+		{"inclusive", NewInclusiveRange(a, b), false},
+		{"inclusive single instant", NewInclusiveRange(a, a), false},
+		{"inclusive reversed", NewInclusiveRange(b, a), true},
+		{"zero value", Range{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.IsEmpty(); got != tt.expected {
+				t.Errorf("IsEmpty() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRangeContains(t *testing.T) {
+	halfOpen := NewRange(MustParse("2026-01-01"), MustParse("2026-02-01"))
+	inclusive := NewInclusiveRange(MustParse("2026-01-01"), MustParse("2026-02-01"))
+
+	tests := []struct {
+		name     string
+		r        Range
+		date     string
+		expected bool
+	}{
+		{"before start", halfOpen, "2025-12-31 23:59:59", false},
+		{"at start", halfOpen, "2026-01-01", true},
+		{"inside", halfOpen, "2026-01-15 12:00:00", true},
+		{"just before end", halfOpen, "2026-01-31 23:59:59", true},
+		{"at end, half-open", halfOpen, "2026-02-01", false},
+		{"at end, inclusive", inclusive, "2026-02-01", true},
+		{"after end, inclusive", inclusive, "2026-02-01 00:00:01", false},
+		{"reversed", NewRange(MustParse("2026-02-01"), MustParse("2026-01-01")), "2026-01-15", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.Contains(MustParse(tt.date)); got != tt.expected {
+				t.Errorf("Contains(%v) = %v, want %v", tt.date, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRangeContainsTimezone(t *testing.T) {
+	r := NewRange(MustParse("2026-01-01"), MustParse("2026-01-02"))
+
+	// 2026-01-02 00:30 in UTC+1 is 2026-01-01 23:30 UTC
+	date := From(time.Date(2026, 1, 2, 0, 30, 0, 0, time.FixedZone("CET", 3600)))
+	if !r.Contains(date) {
+		t.Errorf("Contains(%v) = false, want true", date.Time())
+	}
+}
+
+func TestRangeDuration(t *testing.T) {
+	r := NewRange(MustParse("2026-01-01"), MustParse("2026-02-01"))
+	if got := r.Duration().Days(); got != 31 {
+		t.Errorf("Duration().Days() = %d, want 31", got)
+	}
+
+	reversed := NewRange(MustParse("2026-02-01"), MustParse("2026-01-01"))
+	if got := reversed.Duration().Days(); got != -31 {
+		t.Errorf("reversed Duration().Days() = %d, want -31", got)
+	}
+}
+
+func TestRangeDurationWeekend(t *testing.T) {
+	// Thu 2026-02-12 to Sat 2026-02-14: Friday is a business day only with
+	// the default weekend
+	start, end := MustParse("2026-02-12"), MustParse("2026-02-14")
+	if got := NewRange(start, end).Duration().BusinessDays(); got != 1 {
+		t.Errorf("default weekend BusinessDays() = %d, want 1", got)
+	}
+
+	israel := start.WithWeekend(FridaySaturday)
+	if got := NewRange(israel, end).Duration().BusinessDays(); got != 0 {
+		t.Errorf("FridaySaturday BusinessDays() = %d, want 0", got)
+	}
+
+	// Round trip with AddBusinessDays on the same weekend
+	next := israel.AddBusinessDays(1)
+	if got := NewRange(israel, next).Duration().BusinessDays(); got != 1 {
+		t.Errorf("BusinessDays() to %v = %d, want 1", next, got)
+	}
+}
+
+func TestRangeEach(t *testing.T) {
+	tests := []struct {
+		name     string
+		r        Range
+		unit     Unit
+		expected []string
+	}{
+		{
+			"days half-open",
+			NewRange(MustParse("2026-02-26"), MustParse("2026-03-02")),
+			Days,
+			[]string{"2026-02-26", "2026-02-27", "2026-02-28", "2026-03-01"},
+		},
+		{
+			"days inclusive",
+			NewInclusiveRange(MustParse("2026-02-26"), MustParse("2026-03-02")),
+			Days,
+			[]string{"2026-02-26", "2026-02-27", "2026-02-28", "2026-03-01", "2026-03-02"},
+		},
+		{
+			"months from month end",
+			NewRange(MustParse("2026-01-31"), MustParse("2026-06-01")),
+			Months,
+			[]string{"2026-01-31", "2026-02-28", "2026-03-31", "2026-04-30", "2026-05-31"},
+		},
+		{
+			"quarters",
+			NewInclusiveRange(MustParse("2026-01-01"), MustParse("2026-12-31")),
+			Quarters,
+			[]string{"2026-01-01", "2026-04-01", "2026-07-01", "2026-10-01"},
+		},
+		{
+			"years from leap day",
+			NewInclusiveRange(MustParse("2024-02-29"), MustParse("2028-02-29")),
+			Years,
+			[]string{"2024-02-29", "2025-02-28", "2026-02-28", "2027-02-28", "2028-02-29"},
+		},
+		{
+			"single instant inclusive",
+			NewInclusiveRange(MustParse("2026-01-01"), MustParse("2026-01-01")),
+			Days,
+			[]string{"2026-01-01"},
+		},
+		{
+			"empty",
+			NewRange(MustParse("2026-01-01"), MustParse("2026-01-01")),
+			Days,
+			nil,
+		},
+		{
+			"reversed",
+			NewRange(MustParse("2026-02-01"), MustParse("2026-01-01")),
+			Days,
+			nil,
+		},
+		{
+			"unknown unit",
+			NewRange(MustParse("2026-01-01"), MustParse("2026-02-01")),
+			Unit(99),
+			[]string{"2026-01-01"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := formatAll(tt.r.Each(tt.unit))
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("Each(%v) = %v, want %v", tt.unit, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRangeStep(t *testing.T) {
+	r := NewInclusiveRange(MustParse("2026-01-05"), MustParse("2026-02-16"))
+
+	got := formatAll(r.Step(2, Weeks))
+	expected := []string{"2026-01-05", "2026-01-19", "2026-02-02", "2026-02-16"}
+	if !slices.Equal(got, expected) {
+		t.Errorf("Step(2, Weeks) = %v, want %v", got, expected)
+	}
+
+	for _, n := range []int{0, -1} {
+		if got := formatAll(r.Step(n, Days)); got != nil {
+			t.Errorf("Step(%d, Days) = %v, want no dates", n, got)
+		}
+	}
+}
+
+func TestRangeStepHours(t *testing.T) {
+	r := NewRange(MustParse("2026-01-01 22:00:00"), MustParse("2026-01-02 01:00:00"))
+
+	var got []string
+	for d := range r.Each(Hours) {
+		got = append(got, d.String())
+	}
+	expected := []string{"2026-01-01 22:00:00", "2026-01-01 23:00:00", "2026-01-02 00:00:00"}
+	if !slices.Equal(got, expected) {
+		t.Errorf("Each(Hours) = %v, want %v", got, expected)
+	}
+}
+
+func TestRangeEachDST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	// Spring forward on 2026-03-29: days keep the time of day
+	start := From(time.Date(2026, 3, 28, 9, 0, 0, 0, berlin))
+	end := From(time.Date(2026, 3, 31, 9, 0, 0, 0, berlin))
+
+	var got []string
+	for d := range NewRange(start, end).Each(Days) {
+		got = append(got, d.Time().Format("2006-01-02 15:04 MST"))
+	}
+	expected := []string{"2026-03-28 09:00 CET", "2026-03-29 09:00 CEST", "2026-03-30 09:00 CEST"}
+	if !slices.Equal(got, expected) {
+		t.Errorf("Each(Days) = %v, want %v", got, expected)
+	}
+}
+
+func TestRangeEachBreak(t *testing.T) {
+	r := NewRange(MustParse("2026-01-01"), MustParse("2027-01-01"))
+
+	count := 0
+	for range r.Each(Days) {
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	if count != 3 {
+		t.Errorf("iterations = %d, want 3", count)
+	}
+}
+
+func TestRangeEachPreservesLang(t *testing.T) {
+	r := NewRange(MustParse("2026-01-01").WithLang(DE), MustParse("2026-01-03"))
+	for d := range r.Each(Days) {
+		if d.lang != DE {
+			t.Errorf("Each() yielded lang %v, want %v", d.lang, DE)
+		}
+	}
+}
+
+func TestRangeString(t *testing.T) {
+	a := MustParse("2026-01-01")
+	b := MustParse("2026-02-01")
+
+	if got := NewRange(a, b).String(); got != "[2026-01-01 00:00:00, 2026-02-01 00:00:00)" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := NewInclusiveRange(a, b).String(); got != "[2026-01-01 00:00:00, 2026-02-01 00:00:00]" {
+		t.Errorf("String() = %q", got)
+	}
+}
@@ -0,0 +1,286 @@
+package quando
+
+import "time"
+
+// RetailPattern is the number of weeks in the three periods of each quarter
+// of a retail calendar.
+type RetailPattern int
+
+const (
+	Retail454 RetailPattern = iota // 4-5-4 weeks per quarter (NRF)
+	Retail445                      // 4-4-5 weeks per quarter
+	Retail544                      // 5-4-4 weeks per quarter
+)
+
+// weeks returns the number of weeks of the three periods of a quarter.
+func (p RetailPattern) weeks() [3]int {
+	switch p {
+	case Retail445:
+		return [3]int{4, 4, 5}
+	case Retail544:
+		return [3]int{5, 4, 4}
+	default:
+		return [3]int{4, 5, 4}
+	}
+}
+
+// RetailYearEnd determines on which day a retail year ends.
+type RetailYearEnd int
+
+const (
+	// RetailEndNearest ends the year on the end weekday nearest to the last
+	// day of the end month, which may fall up to three days into the
+	// following month (NRF: the Saturday nearest to January 31).
+	RetailEndNearest RetailYearEnd = iota
+
+	// RetailEndLast ends the year on the last end weekday of the end month.
+	RetailEndLast
+)
+
+// RetailCalendar is a 52/53-week retail calendar. Each year consists of whole
+// weeks ending on a fixed weekday and is divided into four quarters of three
+// periods (retail months) following a 4-5-4, 4-4-5 or 5-4-4 pattern.
+//
+// Because 52 weeks are one or two days shorter than a calendar year, the year
+// end drifts and some years have 53 weeks. The 53rd week is added to the last
+// period of the year.
+//
+// Retail years are named after the calendar year in which most of their days
+// fall: years ending in January to May are named after the year they start
+// in, years ending in June to December after the year they end in. For
+// example, NRF fiscal 2025 runs from Feb 2, 2025 to Jan 31, 2026.
+//
+// The zero value is the NRF calendar (see NewNRFCalendar).
+type RetailCalendar struct {
+	pattern    RetailPattern
+	endMonth   time.Month // 0 = January
+	endWeekday int8       // days after Saturday
+	rule       RetailYearEnd
+}
+
+// NewRetailCalendar returns a retail calendar with the given period pattern
+// whose years end on endWeekday, either nearest to or on the last such
+// weekday of endMonth. An endMonth outside 1-12 is treated as January.
+//
+// Example:
+//
+//	// 4-4-5 calendar, years end on the last Saturday of December
+//	cal := quando.NewRetailCalendar(quando.Retail445, time.December, time.Saturday, quando.RetailEndLast)
+func NewRetailCalendar(pattern RetailPattern, endMonth time.Month, endWeekday time.Weekday, rule RetailYearEnd) RetailCalendar {
+	if endMonth < time.January || endMonth > time.December {
+		endMonth = time.January
+	}
+	return RetailCalendar{
+		pattern:    pattern,
+		endMonth:   endMonth,
+		endWeekday: int8((int(endWeekday)%7 + 1) % 7),
+		rule:       rule,
+	}
+}
+
+// NewNRFCalendar returns the retail calendar of the National Retail
+// Federation: a 4-5-4 pattern with years ending on the Saturday nearest to
+// January 31.
+//
+// Example:
+//
+//	nrf := quando.NewNRFCalendar()
+//	r := quando.MustParse("2026-02-09").Retail(nrf) // fiscal 2026, period 1, week 2
+func NewNRFCalendar() RetailCalendar {
+	return NewRetailCalendar(Retail454, time.January, time.Saturday, RetailEndNearest)
+}
+
+// month returns the month in which the year ends, defaulting to January.
+func (rc RetailCalendar) month() time.Month {
+	if rc.endMonth == 0 {
+		return time.January
+	}
+	return rc.endMonth
+}
+
+// weekday returns the weekday on which years end.
+func (rc RetailCalendar) weekday() time.Weekday {
+	return time.Weekday((int(rc.endWeekday) + 6) % 7)
+}
+
+// yearEnd returns the last day of the retail year that ends in (or around)
+// the end month of the given calendar year, as a civil date in UTC.
+func (rc RetailCalendar) yearEnd(year int) time.Time {
+	// Day 0 of a month is the last day of the previous month
+	last := time.Date(year, rc.month()+1, 0, 0, 0, 0, 0, time.UTC)
+	back := (int(last.Weekday()) - int(rc.weekday()) + 7) % 7
+	if rc.rule == RetailEndNearest && back > 3 {
+		back -= 7
+	}
+	return last.AddDate(0, 0, -back)
+}
+
+// name returns the name of the retail year ending in the given calendar year.
+func (rc RetailCalendar) name(endYear int) int {
+	if rc.month() <= time.May {
+		return endYear - 1
+	}
+	return endYear
+}
+
+// WeeksInYear returns the number of weeks (52 or 53) of the named retail year.
+//
+// Example:
+//
+//	quando.NewNRFCalendar().WeeksInYear(2023) // 53
+//	quando.NewNRFCalendar().WeeksInYear(2025) // 52
+func (rc RetailCalendar) WeeksInYear(year int) int {
+	endYear := year
+	if rc.month() <= time.May {
+		endYear++
+	}
+	days := rc.yearEnd(endYear).Sub(rc.yearEnd(endYear-1)).Hours() / 24
+	return int(days) / 7
+}
+
+// retailPosition locates a day within a retail year.
+type retailPosition struct {
+	endYear int       // calendar year in which the retail year ends
+	start   time.Time // first day of the retail year, civil date in UTC
+	weeks   int       // number of weeks in the year (52 or 53)
+	week    int       // week of the year, 0-based
+	periods [12]int   // first week of each period, 0-based
+	period  int       // period of the year, 0-based
+}
+
+// position locates the calendar day of t within its retail year.
+func (rc RetailCalendar) position(t time.Time) retailPosition {
+	year, month, day := t.Date()
+	civil := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	endYear := year
+	if civil.After(rc.yearEnd(endYear)) {
+		endYear++
+	} else if !civil.After(rc.yearEnd(endYear - 1)) {
+		endYear--
+	}
+
+	p := retailPosition{
+		endYear: endYear,
+		start:   rc.yearEnd(endYear-1).AddDate(0, 0, 1),
+	}
+	p.weeks = int(rc.yearEnd(endYear).Sub(p.start).Hours()/24)/7 + 1
+	p.week = int(civil.Sub(p.start).Hours()/24) / 7
+
+	pattern := rc.pattern.weeks()
+	first := 0
+	for i := range p.periods {
+		p.periods[i] = first
+		if p.week >= first {
+			p.period = i
+		}
+		first += pattern[i%3]
+	}
+	return p
+}
+
+// weekRange returns the first week and the week after the last week (both
+// 0-based) of the given unit containing the position.
+func (p retailPosition) weekRange(unit Unit) (from, to int, ok bool) {
+	periodEnd := func(period int) int {
+		if period >= 11 {
+			return p.weeks // the 53rd week belongs to the last period
+		}
+		return p.periods[period+1]
+	}
+
+	switch unit {
+	case Weeks:
+		return p.week, p.week + 1, true
+	case Months:
+		return p.periods[p.period], periodEnd(p.period), true
+	case Quarters:
+		first := p.period / 3 * 3
+		return p.periods[first], periodEnd(first + 2), true
+	case Years:
+		return 0, p.weeks, true
+	default:
+		return 0, 0, false
+	}
+}
+
+// RetailDate is the position of a date in a retail calendar.
+// Returned by the Retail method.
+type RetailDate struct {
+	Year         int // Retail year (see RetailCalendar for naming)
+	Quarter      int // Retail quarter (1-4)
+	Period       int // Retail period, i.e. retail month (1-12)
+	Week         int // Week of the retail year (1-53)
+	WeekOfPeriod int // Week of the period (1-6)
+}
+
+// Retail returns the position of the date in the given retail calendar,
+// based on the calendar day of the date in its location.
+//
+// Example:
+//
+//	r := quando.MustParse("2026-02-09").Retail(quando.NewNRFCalendar())
+//	// r.Year: 2026, r.Quarter: 1, r.Period: 1, r.Week: 2, r.WeekOfPeriod: 2
+func (d Date) Retail(rc RetailCalendar) RetailDate {
+	p := rc.position(d.t)
+	return RetailDate{
+		Year:         rc.name(p.endYear),
+		Quarter:      p.period/3 + 1,
+		Period:       p.period + 1,
+		Week:         p.week + 1,
+		WeekOfPeriod: p.week - p.periods[p.period] + 1,
+	}
+}
+
+// StartOfRetail returns a new Date snapped to the beginning of the retail
+// week, period, quarter or year, at 00:00:00 in the date's location.
+//
+// Supported units:
+//   - Weeks: first day of the retail week (the day after the end weekday)
+//   - Months: first day of the retail period
+//   - Quarters: first day of the retail quarter
+//   - Years: first day of the retail year
+//
+// Other units return the zero Date (see IsZero).
+//
+// Example:
+//
+//	nrf := quando.NewNRFCalendar()
+//	date := quando.MustParse("2026-02-09")
+//	date.StartOfRetail(nrf, quando.Years)  // Feb 1, 2026 00:00:00 (Sunday)
+//	date.StartOfRetail(nrf, quando.Months) // Feb 1, 2026 00:00:00
+func (d Date) StartOfRetail(rc RetailCalendar, unit Unit) Date {
+	p := rc.position(d.t)
+	from, _, ok := p.weekRange(unit)
+	if !ok {
+		return Date{}
+	}
+	day := p.start.AddDate(0, 0, 7*from)
+	result := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, d.t.Location())
+	return d.with(result)
+}
+
+// EndOfRetail returns a new Date snapped to the end of the retail week,
+// period, quarter or year, at 23:59:59.999999999 in the date's location.
+// In 53-week years, the last period and therefore the last quarter and the
+// year include the extra week.
+//
+// Supported units: Weeks, Months (retail periods), Quarters and Years, as
+// for StartOfRetail. Other units return the zero Date (see IsZero).
+//
+// Example:
+//
+//	nrf := quando.NewNRFCalendar()
+//	date := quando.MustParse("2026-02-09")
+//	date.EndOfRetail(nrf, quando.Months) // Feb 28, 2026 23:59:59 (4 weeks)
+//	date.EndOfRetail(nrf, quando.Years)  // Jan 30, 2027 23:59:59 (Saturday)
+func (d Date) EndOfRetail(rc RetailCalendar, unit Unit) Date {
+	p := rc.position(d.t)
+	_, to, ok := p.weekRange(unit)
+	if !ok {
+		return Date{}
+	}
+	day := p.start.AddDate(0, 0, 7*to-1)
+	result := time.Date(day.Year(), day.Month(), day.Day(), 23, 59, 59, 999999999, d.t.Location())
+	return d.with(result)
+}
@@ -0,0 +1,172 @@
+package quando
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetailNRF(t *testing.T) {
+	nrf := NewNRFCalendar()
+
+	tests := []struct {
+		date string
+		want RetailDate
+	}{
+		// Fiscal 2025: Feb 2, 2025 - Jan 31, 2026
+		{"2025-02-02", RetailDate{Year: 2025, Quarter: 1, Period: 1, Week: 1, WeekOfPeriod: 1}},
+		{"2025-03-01", RetailDate{Year: 2025, Quarter: 1, Period: 1, Week: 4, WeekOfPeriod: 4}},
+		{"2025-03-02", RetailDate{Year: 2025, Quarter: 1, Period: 2, Week: 5, WeekOfPeriod: 1}},
+		{"2025-05-03", RetailDate{Year: 2025, Quarter: 1, Period: 3, Week: 13, WeekOfPeriod: 4}},
+		{"2025-05-04", RetailDate{Year: 2025, Quarter: 2, Period: 4, Week: 14, WeekOfPeriod: 1}},
+		{"2026-01-31", RetailDate{Year: 2025, Quarter: 4, Period: 12, Week: 52, WeekOfPeriod: 4}},
+		// Fiscal 2026 starts on Feb 1, 2026
+		{"2026-02-01", RetailDate{Year: 2026, Quarter: 1, Period: 1, Week: 1, WeekOfPeriod: 1}},
+		{"2026-02-09", RetailDate{Year: 2026, Quarter: 1, Period: 1, Week: 2, WeekOfPeriod: 2}},
+		// Fiscal 2023 has 53 weeks: Jan 29, 2023 - Feb 3, 2024
+		{"2023-01-28", RetailDate{Year: 2022, Quarter: 4, Period: 12, Week: 52, WeekOfPeriod: 4}},
+		{"2023-01-29", RetailDate{Year: 2023, Quarter: 1, Period: 1, Week: 1, WeekOfPeriod: 1}},
+		{"2024-01-31", RetailDate{Year: 2023, Quarter: 4, Period: 12, Week: 53, WeekOfPeriod: 5}},
+		{"2024-02-03", RetailDate{Year: 2023, Quarter: 4, Period: 12, Week: 53, WeekOfPeriod: 5}},
+		{"2024-02-04", RetailDate{Year: 2024, Quarter: 1, Period: 1, Week: 1, WeekOfPeriod: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			if got := MustParse(tt.date).Retail(nrf); got != tt.want {
+				t.Errorf("Retail() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetailZeroValueIsNRF(t *testing.T) {
+	date := MustParse("2024-02-03")
+	if got, want := date.Retail(RetailCalendar{}), date.Retail(NewNRFCalendar()); got != want {
+		t.Errorf("Retail(RetailCalendar{}) = %+v, want %+v", got, want)
+	}
+}
+
+func TestRetailWeeksInYear(t *testing.T) {
+	nrf := NewNRFCalendar()
+	for year, want := range map[int]int{2017: 53, 2018: 52, 2022: 52, 2023: 53, 2024: 52, 2025: 52, 2028: 53} {
+		if got := nrf.WeeksInYear(year); got != want {
+			t.Errorf("NRF WeeksInYear(%d) = %d, want %d", year, got, want)
+		}
+	}
+
+	// Last Saturday of January: 2024 ends Jan 25, 2025; 2025 ends Jan 31, 2026
+	last := NewRetailCalendar(Retail454, time.January, time.Saturday, RetailEndLast)
+	for year, want := range map[int]int{2022: 52, 2023: 52, 2024: 52, 2025: 53} {
+		if got := last.WeeksInYear(year); got != want {
+			t.Errorf("last Saturday WeeksInYear(%d) = %d, want %d", year, got, want)
+		}
+	}
+}
+
+func TestRetailPatterns(t *testing.T) {
+	// Years end on the last Saturday of December: 2026 runs Dec 28, 2025 - Dec 26, 2026
+	tests := []struct {
+		name    string
+		pattern RetailPattern
+		date    string
+		want    RetailDate
+		start   string
+		end     string
+	}{
+		{"4-4-5 period 3", Retail445, "2026-03-01", RetailDate{2026, 1, 3, 10, 2}, "2026-02-22 00:00:00", "2026-03-28 23:59:59"},
+		{"4-4-5 period 2", Retail445, "2026-02-21", RetailDate{2026, 1, 2, 8, 4}, "2026-01-25 00:00:00", "2026-02-21 23:59:59"},
+		{"5-4-4 period 1", Retail544, "2026-01-31", RetailDate{2026, 1, 1, 5, 5}, "2025-12-28 00:00:00", "2026-01-31 23:59:59"},
+		{"4-5-4 period 2", Retail454, "2026-01-31", RetailDate{2026, 1, 2, 5, 1}, "2026-01-25 00:00:00", "2026-02-28 23:59:59"},
+		{"year start", Retail445, "2025-12-28", RetailDate{2026, 1, 1, 1, 1}, "2025-12-28 00:00:00", "2026-01-24 23:59:59"},
+		{"previous year end", Retail445, "2025-12-27", RetailDate{2025, 4, 12, 52, 5}, "2025-11-23 00:00:00", "2025-12-27 23:59:59"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cal := NewRetailCalendar(tt.pattern, time.December, time.Saturday, RetailEndLast)
+			date := MustParse(tt.date)
+			if got := date.Retail(cal); got != tt.want {
+				t.Errorf("Retail() = %+v, want %+v", got, tt.want)
+			}
+			if got := date.StartOfRetail(cal, Months).String(); got != tt.start {
+				t.Errorf("StartOfRetail(Months) = %s, want %s", got, tt.start)
+			}
+			if got := date.EndOfRetail(cal, Months).String(); got != tt.end {
+				t.Errorf("EndOfRetail(Months) = %s, want %s", got, tt.end)
+			}
+		})
+	}
+}
+
+func TestStartEndOfRetail(t *testing.T) {
+	nrf := NewNRFCalendar()
+
+	tests := []struct {
+		name  string
+		date  string
+		unit  Unit
+		start string
+		end   string
+	}{
+		{"week", "2026-02-11 15:00:00", Weeks, "2026-02-08 00:00:00", "2026-02-14 23:59:59"},
+		{"period", "2026-02-11 15:00:00", Months, "2026-02-01 00:00:00", "2026-02-28 23:59:59"},
+		{"quarter", "2026-02-11 15:00:00", Quarters, "2026-02-01 00:00:00", "2026-05-02 23:59:59"},
+		{"year", "2026-02-11 15:00:00", Years, "2026-02-01 00:00:00", "2027-01-30 23:59:59"},
+		{"53-week period", "2024-01-10 08:00:00", Months, "2023-12-31 00:00:00", "2024-02-03 23:59:59"},
+		{"53-week quarter", "2024-01-10 08:00:00", Quarters, "2023-10-29 00:00:00", "2024-02-03 23:59:59"},
+		{"53-week year", "2024-01-10 08:00:00", Years, "2023-01-29 00:00:00", "2024-02-03 23:59:59"},
+		{"unsupported unit", "2026-02-11 15:00:00", Days, "0001-01-01 00:00:00", "0001-01-01 00:00:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date := MustParse(tt.date)
+			if got := date.StartOfRetail(nrf, tt.unit).String(); got != tt.start {
+				t.Errorf("StartOfRetail() = %s, want %s", got, tt.start)
+			}
+			if got := date.EndOfRetail(nrf, tt.unit).String(); got != tt.end {
+				t.Errorf("EndOfRetail() = %s, want %s", got, tt.end)
+			}
+		})
+	}
+}
+
+func TestRetailConsistency(t *testing.T) {
+	calendars := map[string]RetailCalendar{
+		"NRF":            NewNRFCalendar(),
+		"4-4-5 December": NewRetailCalendar(Retail445, time.December, time.Saturday, RetailEndLast),
+		"5-4-4 June":     NewRetailCalendar(Retail544, time.June, time.Sunday, RetailEndNearest),
+	}
+
+	for name, cal := range calendars {
+		t.Run(name, func(t *testing.T) {
+			prev := From(time.Date(2019, 12, 31, 12, 0, 0, 0, time.UTC)).Retail(cal)
+			for day := From(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)); day.Time().Year() < 2031; day = day.Add(1, Days) {
+				r := day.Retail(cal)
+
+				// Positions advance by at most one week per day
+				switch {
+				case r.Year == prev.Year && r.Week == prev.Week:
+				case r.Year == prev.Year && r.Week == prev.Week+1:
+				case r.Year == prev.Year+1 && r.Week == 1 && prev.Week == cal.WeeksInYear(prev.Year):
+				default:
+					t.Fatalf("%s: %+v follows %+v", day, r, prev)
+				}
+				prev = r
+
+				for _, unit := range []Unit{Weeks, Months, Quarters, Years} {
+					start, end := day.StartOfRetail(cal, unit), day.EndOfRetail(cal, unit)
+					if day.Before(start) || day.After(end) {
+						t.Fatalf("%s: %v not in [%v, %v]", day, unit, start, end)
+					}
+					if start.Retail(cal).Year != r.Year || end.Retail(cal).Year != r.Year {
+						t.Fatalf("%s: %v crosses the retail year", day, unit)
+					}
+				}
+				if got := day.StartOfRetail(cal, Weeks).Time().Weekday(); got != (cal.weekday()+1)%7 {
+					t.Fatalf("%s: week starts on %v", day, got)
+				}
+			}
+		})
+	}
+}
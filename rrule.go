@@ -0,0 +1,562 @@
+package quando
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// frequency is the FREQ part of a recurrence rule.
+type frequency int
+
+const (
+	yearly frequency = iota
+	monthly
+	weekly
+	daily
+)
+
+// frequencyNames maps frequencies to their RFC 5545 names.
+var frequencyNames = [...]string{
+	yearly:  "YEARLY",
+	monthly: "MONTHLY",
+	weekly:  "WEEKLY",
+	daily:   "DAILY",
+}
+
+// weekdayCodes maps weekdays to their RFC 5545 two-letter codes.
+var weekdayCodes = [...]string{
+	time.Sunday:    "SU",
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+}
+
+// Layouts of the UNTIL part: a date, a floating (local) date-time, or a UTC
+// date-time.
+const (
+	untilDateLayout  = "20060102"
+	untilLocalLayout = "20060102T150405"
+	untilUTCLayout   = "20060102T150405Z"
+)
+
+// weekdayNum is a BYDAY entry such as "MO" (every Monday) or "-1FR" (the last
+// Friday of the month or year).
+type weekdayNum struct {
+	n       int // ordinal, 0 = every occurrence
+	weekday time.Weekday
+}
+
+// RRule is a recurrence rule as defined by RFC 5545 (iCalendar), e.g.
+// "FREQ=MONTHLY;BYDAY=2TU" for the second Tuesday of every month.
+//
+// Create rules with ParseRRule and generate dates with Occurrences.
+// RRule is a value type and safe for concurrent use.
+//
+// Supported parts: FREQ (YEARLY, MONTHLY, WEEKLY, DAILY), INTERVAL, COUNT,
+// UNTIL, BYMONTH, BYMONTHDAY (including negative values counting from the end
+// of the month), BYDAY (with ordinals for MONTHLY and YEARLY rules), BYSETPOS
+// and WKST. Sub-daily frequencies and BYYEARDAY, BYWEEKNO, BYHOUR, BYMINUTE
+// and BYSECOND are not supported and rejected by ParseRRule.
+//
+// Month-End Behavior:
+//
+// When a MONTHLY or YEARLY rule takes the day of the month from the start date
+// (no BYMONTHDAY or BYDAY), the occurrences follow the month-end policy of the
+// start date, exactly as with Add(n, Months) (see WithMonthEndPolicy). With
+// the default MonthEndClamp, days that do not exist in a month are clamped to
+// the last day of the month, so a yearly rule starting on February 29 falls
+// back to February 28 in common years. With MonthEndSticky, a rule starting on
+// the last day of a month stays on the last day of every month. RFC 5545
+// would skip nonexistent days instead; use BYMONTHDAY to get that behavior.
+type RRule struct {
+	freq        frequency
+	interval    int
+	count       int
+	until       time.Time // wall clock fields; see untilLayout
+	untilLayout string    // empty if the rule has no UNTIL
+	byMonth     []time.Month
+	byMonthDay  []int
+	byDay       []weekdayNum
+	bySetPos    []int
+	weekStart   time.Weekday
+}
+
+// ParseRRule parses an RFC 5545 recurrence rule. The "RRULE:" prefix is
+// optional and parts are case-insensitive.
+//
+// UNTIL may be a date ("20261231", inclusive), a UTC date-time
+// ("20261231T235959Z") or a floating date-time ("20261231T235959"), which is
+// interpreted in the location of the start date passed to Occurrences.
+//
+// Returns ErrInvalidFormat if the rule is malformed, contains unsupported
+// parts, or combines parts in a way RFC 5545 does not allow (e.g. COUNT
+// together with UNTIL).
+//
+// Example:
+//
+//	rule, err := quando.ParseRRule("FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1")
+//	if err != nil {
+//	    return err
+//	}
+//	// rule: last weekday of every month
+func ParseRRule(s string) (RRule, error) {
+	input := strings.ToUpper(strings.TrimSpace(s))
+	input = strings.TrimPrefix(input, "RRULE:")
+	if input == "" {
+		return RRule{}, fmt.Errorf("parsing RRULE %q: empty rule: %w", s, ErrInvalidFormat)
+	}
+
+	r := RRule{interval: 1, weekStart: time.Monday}
+	hasFreq := false
+	seen := make(map[string]bool)
+
+	for part := range strings.SplitSeq(input, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return RRule{}, fmt.Errorf("parsing RRULE %q: invalid part %q: %w", s, part, ErrInvalidFormat)
+		}
+		if seen[name] {
+			return RRule{}, fmt.Errorf("parsing RRULE %q: duplicate part %s: %w", s, name, ErrInvalidFormat)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			freq := slices.Index(frequencyNames[:], value)
+			if freq < 0 {
+				err = fmt.Errorf("unsupported FREQ %q (supported: YEARLY, MONTHLY, WEEKLY, DAILY)", value)
+			}
+			r.freq = frequency(freq)
+			hasFreq = true
+		case "INTERVAL":
+			r.interval, err = parsePositive(value)
+		case "COUNT":
+			r.count, err = parsePositive(value)
+		case "UNTIL":
+			r.until, r.untilLayout, err = parseUntil(value)
+		case "BYMONTH":
+			var months []int
+			months, err = parseIntList(value, 1, 12, false)
+			for _, m := range months {
+				r.byMonth = append(r.byMonth, time.Month(m))
+			}
+		case "BYMONTHDAY":
+			r.byMonthDay, err = parseIntList(value, 1, 31, true)
+		case "BYDAY":
+			r.byDay, err = parseWeekdayNums(value)
+		case "BYSETPOS":
+			r.bySetPos, err = parseIntList(value, 1, 366, true)
+		case "WKST":
+			r.weekStart, err = parseWeekdayCode(value)
+		case "BYYEARDAY", "BYWEEKNO", "BYHOUR", "BYMINUTE", "BYSECOND":
+			err = fmt.Errorf("%s is not supported", name)
+		default:
+			err = fmt.Errorf("unknown part %q", name)
+		}
+		if err != nil {
+			return RRule{}, fmt.Errorf("parsing RRULE %q: %v: %w", s, err, ErrInvalidFormat)
+		}
+	}
+
+	if err := r.validate(hasFreq); err != nil {
+		return RRule{}, fmt.Errorf("parsing RRULE %q: %v: %w", s, err, ErrInvalidFormat)
+	}
+
+	// Sorted month lists keep the occurrences of a period in chronological order
+	slices.Sort(r.byMonth)
+	r.byMonth = slices.Compact(r.byMonth)
+	return r, nil
+}
+
+// MustParseRRule is like ParseRRule but panics if the rule cannot be parsed.
+// It simplifies safe initialization of global variables holding rules.
+//
+// Example:
+//
+//	var everySecondTuesday = quando.MustParseRRule("FREQ=MONTHLY;BYDAY=2TU")
+func MustParseRRule(s string) RRule {
+	rule, err := ParseRRule(s)
+	if err != nil {
+		panic(fmt.Sprintf("quando.MustParseRRule(%q): %v", s, err))
+	}
+	return rule
+}
+
+// validate checks the combination of parts of a parsed rule.
+func (r RRule) validate(hasFreq bool) error {
+	switch {
+	case !hasFreq:
+		return fmt.Errorf("FREQ is required")
+	case r.count > 0 && r.untilLayout != "":
+		return fmt.Errorf("COUNT and UNTIL must not be combined")
+	case r.freq == weekly && len(r.byMonthDay) > 0:
+		return fmt.Errorf("BYMONTHDAY is not allowed with FREQ=WEEKLY")
+	case len(r.bySetPos) > 0 && len(r.byMonth) == 0 && len(r.byMonthDay) == 0 && len(r.byDay) == 0:
+		return fmt.Errorf("BYSETPOS requires another BYxxx part")
+	}
+
+	for _, wd := range r.byDay {
+		if wd.n == 0 {
+			continue
+		}
+		switch {
+		case r.freq != monthly && r.freq != yearly:
+			return fmt.Errorf("BYDAY ordinals require FREQ=MONTHLY or FREQ=YEARLY")
+		case (r.freq == monthly || len(r.byMonth) > 0) && (wd.n > 5 || wd.n < -5):
+			return fmt.Errorf("BYDAY ordinal %d out of range for a month", wd.n)
+		}
+	}
+	return nil
+}
+
+// Occurrences returns an iterator over the dates of the rule, starting at
+// dtstart. The time of day and the location of dtstart are used for all
+// occurrences; days are calendar days, so the wall clock time is kept across
+// DST transitions.
+//
+// dtstart is yielded only if it matches the rule. The iterator ends after
+// COUNT occurrences, after UNTIL, or if no further occurrence exists. Rules
+// without COUNT and UNTIL are unbounded, so stop iterating with break.
+//
+// Example:
+//
+//	rule := quando.MustParseRRule("FREQ=MONTHLY;INTERVAL=2;BYDAY=2TU;COUNT=3")
+//	for d := range rule.Occurrences(quando.MustParse("2026-01-01 10:00:00")) {
+//	    fmt.Println(d) // 2026-01-13 10:00:00, 2026-03-10 10:00:00, 2026-05-12 10:00:00
+//	}
+func (r RRule) Occurrences(dtstart Date) iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		start := dtstart.t
+		until, hasUntil := r.untilIn(start.Location())
+		interval := max(r.interval, 1)
+
+		count := 0
+		lastYear := start.Year()
+		for k := 0; ; k++ {
+			first := r.periodStart(start, k*interval)
+			// The Gregorian calendar repeats every 400 years: a rule without
+			// occurrences for that long has no further occurrences.
+			if first.Year() > 9999 || first.Year() > lastYear+400 {
+				return
+			}
+
+			for _, t := range r.expand(start, first, dtstart.monthEnd) {
+				lastYear = first.Year()
+				if t.Before(start) {
+					continue
+				}
+				if hasUntil && t.After(until) {
+					return
+				}
+				if !yield(dtstart.with(t)) {
+					return
+				}
+				count++
+				if r.count > 0 && count >= r.count {
+					return
+				}
+			}
+		}
+	}
+}
+
+// String returns the rule in RFC 5545 format without the "RRULE:" prefix.
+// INTERVAL=1 and WKST=MO are omitted as they are the defaults.
+//
+// Example:
+//
+//	quando.MustParseRRule("rrule:freq=monthly;byday=2tu").String() // "FREQ=MONTHLY;BYDAY=2TU"
+func (r RRule) String() string {
+	parts := []string{"FREQ=" + frequencyNames[r.freq]}
+	if r.interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.interval))
+	}
+	if r.count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.count))
+	}
+	if r.untilLayout != "" {
+		parts = append(parts, "UNTIL="+r.until.Format(r.untilLayout))
+	}
+	if len(r.byMonth) > 0 {
+		months := make([]string, len(r.byMonth))
+		for i, m := range r.byMonth {
+			months[i] = strconv.Itoa(int(m))
+		}
+		parts = append(parts, "BYMONTH="+strings.Join(months, ","))
+	}
+	if len(r.byMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinInts(r.byMonthDay))
+	}
+	if len(r.byDay) > 0 {
+		days := make([]string, len(r.byDay))
+		for i, wd := range r.byDay {
+			days[i] = weekdayCodes[wd.weekday]
+			if wd.n != 0 {
+				days[i] = strconv.Itoa(wd.n) + days[i]
+			}
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.bySetPos) > 0 {
+		parts = append(parts, "BYSETPOS="+joinInts(r.bySetPos))
+	}
+	if r.weekStart != time.Monday {
+		parts = append(parts, "WKST="+weekdayCodes[r.weekStart])
+	}
+	return strings.Join(parts, ";")
+}
+
+// untilIn resolves UNTIL to an inclusive limit in the given location.
+func (r RRule) untilIn(loc *time.Location) (time.Time, bool) {
+	u := r.until
+	switch r.untilLayout {
+	case untilUTCLayout:
+		return u, true
+	case untilLocalLayout:
+		return time.Date(u.Year(), u.Month(), u.Day(), u.Hour(), u.Minute(), u.Second(), 0, loc), true
+	case untilDateLayout:
+		return time.Date(u.Year(), u.Month(), u.Day(), 23, 59, 59, 999999999, loc), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// periodStart returns the first calendar day (at 00:00 UTC) of the period
+// that lies the given number of periods after the one containing start.
+func (r RRule) periodStart(start time.Time, periods int) time.Time {
+	year, month, day := start.Date()
+	switch r.freq {
+	case yearly:
+		return time.Date(year+periods, time.January, 1, 0, 0, 0, 0, time.UTC)
+	case monthly:
+		return time.Date(year, month+time.Month(periods), 1, 0, 0, 0, 0, time.UTC)
+	case weekly:
+		offset := (int(start.Weekday()) - int(r.weekStart) + 7) % 7
+		return time.Date(year, month, day-offset+7*periods, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(year, month, day+periods, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// expand returns the occurrences of the period beginning at first in
+// chronological order, with BYSETPOS applied. Occurrences before start are
+// included; the caller filters them.
+func (r RRule) expand(start time.Time, first time.Time, policy MonthEndPolicy) []time.Time {
+	hasDayRules := len(r.byMonthDay) > 0 || len(r.byDay) > 0
+
+	var result []time.Time
+	switch r.freq {
+	case yearly:
+		months := r.byMonth
+		switch {
+		case !hasDayRules:
+			if len(months) == 0 {
+				months = []time.Month{start.Month()}
+			}
+			// Day of month from start, with the start date's month-end policy
+			for _, m := range months {
+				offset := (first.Year()-start.Year())*12 + int(m) - int(start.Month())
+				result = append(result, addMonths(start, offset, policy))
+			}
+		case len(months) == 0:
+			// BYDAY ordinals count within the year
+			days := int(first.AddDate(1, 0, 0).Sub(first).Hours() / 24)
+			result = r.expandDays(start, first, days)
+		default:
+			for _, m := range months {
+				monthStart := time.Date(first.Year(), m, 1, 0, 0, 0, 0, time.UTC)
+				result = append(result, r.expandDays(start, monthStart, daysInMonth(monthStart))...)
+			}
+		}
+
+	case monthly:
+		if len(r.byMonth) > 0 && !slices.Contains(r.byMonth, first.Month()) {
+			return nil
+		}
+		if !hasDayRules {
+			offset := (first.Year()-start.Year())*12 + int(first.Month()) - int(start.Month())
+			result = append(result, addMonths(start, offset, policy))
+			break
+		}
+		result = r.expandDays(start, first, daysInMonth(first))
+
+	case weekly:
+		result = r.expandDays(start, first, 7)
+
+	default:
+		result = r.expandDays(start, first, 1)
+	}
+
+	return r.applySetPos(result)
+}
+
+// expandDays returns the days among the n days beginning at first that match
+// the rule, at the time of day of start. Ordinals in BYDAY count within these
+// n days.
+func (r RRule) expandDays(start time.Time, first time.Time, n int) []time.Time {
+	var result []time.Time
+	for i := range n {
+		day := first.AddDate(0, 0, i)
+		if !r.matches(start, day, i, n) {
+			continue
+		}
+		result = append(result, time.Date(day.Year(), day.Month(), day.Day(),
+			start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location()))
+	}
+	return result
+}
+
+// matches reports whether day, the index-th of n days in its scope, passes
+// the BYMONTH, BYMONTHDAY and BYDAY parts of the rule.
+func (r RRule) matches(start time.Time, day time.Time, index, n int) bool {
+	if len(r.byMonth) > 0 && !slices.Contains(r.byMonth, day.Month()) {
+		return false
+	}
+
+	if len(r.byMonthDay) > 0 {
+		last := daysInMonth(day)
+		if !slices.ContainsFunc(r.byMonthDay, func(md int) bool {
+			return md == day.Day() || (md < 0 && last+md+1 == day.Day())
+		}) {
+			return false
+		}
+	}
+
+	if len(r.byDay) > 0 {
+		fromStart := index/7 + 1
+		fromEnd := -((n-1-index)/7 + 1)
+		return slices.ContainsFunc(r.byDay, func(wd weekdayNum) bool {
+			return wd.weekday == day.Weekday() && (wd.n == 0 || wd.n == fromStart || wd.n == fromEnd)
+		})
+	}
+
+	// Weekly rules without BYDAY repeat on the weekday of the start date
+	if r.freq == weekly {
+		return day.Weekday() == start.Weekday()
+	}
+	return true
+}
+
+// applySetPos selects the BYSETPOS positions from the occurrences of a period.
+func (r RRule) applySetPos(set []time.Time) []time.Time {
+	if len(r.bySetPos) == 0 {
+		return set
+	}
+
+	var indexes []int
+	for _, pos := range r.bySetPos {
+		i := pos - 1
+		if pos < 0 {
+			i = len(set) + pos
+		}
+		if i >= 0 && i < len(set) {
+			indexes = append(indexes, i)
+		}
+	}
+	slices.Sort(indexes)
+	indexes = slices.Compact(indexes)
+
+	result := make([]time.Time, len(indexes))
+	for i, index := range indexes {
+		result[i] = set[index]
+	}
+	return result
+}
+
+// daysInMonth returns the number of days in the month of t.
+func daysInMonth(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// parsePositive parses a positive integer.
+func parsePositive(s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid positive number %q", s)
+	}
+	return n, nil
+}
+
+// parseIntList parses a comma-separated list of integers whose absolute
+// values lie within [lo, hi]. Negative values are accepted only if
+// allowNegative is set.
+func parseIntList(s string, lo, hi int, allowNegative bool) ([]int, error) {
+	var result []int
+	for item := range strings.SplitSeq(s, ",") {
+		n, err := strconv.Atoi(item)
+		abs := n
+		if n < 0 && allowNegative {
+			abs = -n
+		}
+		if err != nil || abs < lo || abs > hi {
+			return nil, fmt.Errorf("invalid value %q", item)
+		}
+		result = append(result, n)
+	}
+	return result, nil
+}
+
+// parseWeekdayNums parses a BYDAY list such as "MO,WE" or "2TU,-1FR".
+func parseWeekdayNums(s string) ([]weekdayNum, error) {
+	var result []weekdayNum
+	for item := range strings.SplitSeq(s, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("invalid weekday %q", item)
+		}
+		code, ordinal := item[len(item)-2:], item[:len(item)-2]
+
+		weekday, err := parseWeekdayCode(code)
+		if err != nil {
+			return nil, err
+		}
+
+		n := 0
+		if ordinal != "" {
+			n, err = strconv.Atoi(ordinal)
+			if err != nil || n == 0 || n > 53 || n < -53 {
+				return nil, fmt.Errorf("invalid weekday ordinal %q", item)
+			}
+		}
+		result = append(result, weekdayNum{n: n, weekday: weekday})
+	}
+	return result, nil
+}
+
+// parseWeekdayCode parses a two-letter weekday code such as "MO".
+func parseWeekdayCode(s string) (time.Weekday, error) {
+	i := slices.Index(weekdayCodes[:], s)
+	if i < 0 {
+		return 0, fmt.Errorf("invalid weekday %q", s)
+	}
+	return time.Weekday(i), nil
+}
+
+// parseUntil parses the value of UNTIL and returns the layout it matched.
+func parseUntil(s string) (time.Time, string, error) {
+	for _, layout := range []string{untilUTCLayout, untilLocalLayout, untilDateLayout} {
+		if len(s) != len(layout) {
+			continue
+		}
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, layout, nil
+		}
+	}
+	return time.Time{}, "", fmt.Errorf("invalid UNTIL %q", s)
+}
+
+// joinInts formats integers as a comma-separated list.
+func joinInts(values []int) string {
+	items := make([]string, len(values))
+	for i, v := range values {
+		items[i] = strconv.Itoa(v)
+	}
+	return strings.Join(items, ",")
+}
@@ -0,0 +1,394 @@
+package quando
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+// occurrences collects up to limit occurrences of a rule as strings
+func occurrences(t *testing.T, rule, dtstart string, limit int) []string {
+	t.Helper()
+	r, err := ParseRRule(rule)
+	if err != nil {
+		t.Fatalf("ParseRRule(%q) error = %v", rule, err)
+	}
+
+	var result []string
+	for d := range r.Occurrences(MustParse(dtstart)) {
+		result = append(result, d.String())
+		if len(result) == limit {
+			break
+		}
+	}
+	return result
+}
+
+func TestRRuleOccurrences(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     string
+		dtstart  string
+		expected []string
+	}{
+		{
+			"second Tuesday of every month",
+			"FREQ=MONTHLY;BYDAY=2TU;COUNT=3",
+			"2026-01-01 10:00:00",
+			[]string{"2026-01-13 10:00:00", "2026-02-10 10:00:00", "2026-03-10 10:00:00"},
+		},
+		{
+			"every other Tuesday",
+			"FREQ=WEEKLY;INTERVAL=2;BYDAY=TU;COUNT=3",
+			"2026-01-06 09:00:00",
+			[]string{"2026-01-06 09:00:00", "2026-01-20 09:00:00", "2026-02-03 09:00:00"},
+		},
+		{
+			"weekly on the start weekday",
+			"FREQ=WEEKLY;COUNT=3",
+			"2026-01-07 09:00:00",
+			[]string{"2026-01-07 09:00:00", "2026-01-14 09:00:00", "2026-01-21 09:00:00"},
+		},
+		{
+			"last weekday of each month",
+			"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=5",
+			"2026-01-01",
+			[]string{
+				"2026-01-30 00:00:00", "2026-02-27 00:00:00", "2026-03-31 00:00:00",
+				"2026-04-30 00:00:00", "2026-05-29 00:00:00",
+			},
+		},
+		{
+			"yearly on Feb 29 falls back to Feb 28",
+			"FREQ=YEARLY;COUNT=5",
+			"2024-02-29",
+			[]string{
+				"2024-02-29 00:00:00", "2025-02-28 00:00:00", "2026-02-28 00:00:00",
+				"2027-02-28 00:00:00", "2028-02-29 00:00:00",
+			},
+		},
+		{
+			"yearly only on Feb 29",
+			"FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29;COUNT=2",
+			"2026-01-01",
+			[]string{"2028-02-29 00:00:00", "2032-02-29 00:00:00"},
+		},
+		{
+			"monthly from the 31st clamps to month end",
+			"FREQ=MONTHLY;COUNT=4",
+			"2026-01-31",
+			[]string{"2026-01-31 00:00:00", "2026-02-28 00:00:00", "2026-03-31 00:00:00", "2026-04-30 00:00:00"},
+		},
+		{
+			"monthly from the 31st does not drift",
+			"FREQ=MONTHLY;INTERVAL=2;COUNT=3",
+			"2026-08-31",
+			[]string{"2026-08-31 00:00:00", "2026-10-31 00:00:00", "2026-12-31 00:00:00"},
+		},
+		{
+			"last day of the month",
+			"FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3",
+			"2026-01-15",
+			[]string{"2026-01-31 00:00:00", "2026-02-28 00:00:00", "2026-03-31 00:00:00"},
+		},
+		{
+			"yearly in some months",
+			"FREQ=YEARLY;BYMONTH=2,4;COUNT=3",
+			"2026-03-31",
+			[]string{"2026-04-30 00:00:00", "2027-02-28 00:00:00", "2027-04-30 00:00:00"},
+		},
+		{
+			"daily with BYDAY filter",
+			"FREQ=DAILY;BYDAY=SA,SU;COUNT=4",
+			"2026-01-01",
+			[]string{"2026-01-03 00:00:00", "2026-01-04 00:00:00", "2026-01-10 00:00:00", "2026-01-11 00:00:00"},
+		},
+		{
+			"daily until date (inclusive)",
+			"FREQ=DAILY;INTERVAL=2;UNTIL=20260105",
+			"2026-01-01 09:00:00",
+			[]string{"2026-01-01 09:00:00", "2026-01-03 09:00:00", "2026-01-05 09:00:00"},
+		},
+		{
+			"daily until UTC date-time",
+			"FREQ=DAILY;UNTIL=20260103T090000Z",
+			"2026-01-01 09:00:00",
+			[]string{"2026-01-01 09:00:00", "2026-01-02 09:00:00", "2026-01-03 09:00:00"},
+		},
+		{
+			"daily until floating date-time",
+			"FREQ=DAILY;UNTIL=20260103T085959",
+			"2026-01-01 09:00:00",
+			[]string{"2026-01-01 09:00:00", "2026-01-02 09:00:00"},
+		},
+		{
+			"impossible rule ends",
+			"FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30",
+			"2026-01-01",
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := occurrences(t, tt.rule, tt.dtstart, 20)
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("Occurrences(%q) = %v, want %v", tt.rule, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestRRuleRFC5545Examples checks examples from RFC 5545, section 3.8.5.3
+func TestRRuleRFC5545Examples(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     string
+		dtstart  string
+		expected []string
+	}{
+		{
+			"weekly on Tuesday and Sunday, WKST=MO",
+			"FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=MO",
+			"1997-08-05 09:00:00",
+			[]string{"1997-08-05", "1997-08-10", "1997-08-19", "1997-08-24"},
+		},
+		{
+			"weekly on Tuesday and Sunday, WKST=SU",
+			"FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=SU",
+			"1997-08-05 09:00:00",
+			[]string{"1997-08-05", "1997-08-17", "1997-08-19", "1997-08-31"},
+		},
+		{
+			"20th Monday of the year",
+			"FREQ=YEARLY;BYDAY=20MO;COUNT=3",
+			"1997-05-19 09:00:00",
+			[]string{"1997-05-19", "1998-05-18", "1999-05-17"},
+		},
+		{
+			"second-to-last Monday of the month",
+			"FREQ=MONTHLY;COUNT=6;BYDAY=-2MO",
+			"1997-09-22 09:00:00",
+			[]string{"1997-09-22", "1997-10-20", "1997-11-17", "1997-12-22", "1998-01-19", "1998-02-16"},
+		},
+		{
+			"third-to-last day of the month",
+			"FREQ=MONTHLY;BYMONTHDAY=-3;COUNT=4",
+			"1997-09-04 09:00:00",
+			[]string{"1997-09-28", "1997-10-29", "1997-11-28", "1997-12-29"},
+		},
+		{
+			"third Tuesday, Wednesday or Thursday of the month",
+			"FREQ=MONTHLY;COUNT=3;BYDAY=TU,WE,TH;BYSETPOS=3",
+			"1997-09-04 09:00:00",
+			[]string{"1997-09-04", "1997-10-07", "1997-11-06"},
+		},
+		{
+			"every Friday the 13th",
+			"FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13;COUNT=5",
+			"1997-09-02 09:00:00",
+			[]string{"1998-02-13", "1998-03-13", "1998-11-13", "1999-08-13", "2000-10-13"},
+		},
+		{
+			"first Saturday that follows the first Sunday of the month",
+			"FREQ=MONTHLY;BYDAY=SA;BYMONTHDAY=7,8,9,10,11,12,13;COUNT=4",
+			"1997-09-13 09:00:00",
+			[]string{"1997-09-13", "1997-10-11", "1997-11-08", "1997-12-13"},
+		},
+		{
+			"US Presidential Election day",
+			"FREQ=YEARLY;INTERVAL=4;BYMONTH=11;BYDAY=TU;BYMONTHDAY=2,3,4,5,6,7,8;COUNT=3",
+			"1996-11-05 09:00:00",
+			[]string{"1996-11-05", "2000-11-07", "2004-11-02"},
+		},
+		{
+			"every Thursday in March",
+			"FREQ=YEARLY;BYMONTH=3;BYDAY=TH;COUNT=6",
+			"1997-03-13 09:00:00",
+			[]string{"1997-03-13", "1997-03-20", "1997-03-27", "1998-03-05", "1998-03-12", "1998-03-19"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, s := range occurrences(t, tt.rule, tt.dtstart, 20) {
+				if s[11:] != "09:00:00" {
+					t.Errorf("occurrence %v, want time 09:00:00", s)
+				}
+				got = append(got, s[:10])
+			}
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("Occurrences(%q) = %v, want %v", tt.rule, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRRuleOccurrencesDST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	rule := MustParseRRule("FREQ=WEEKLY;COUNT=2")
+	start := From(time.Date(2026, 3, 23, 9, 0, 0, 0, berlin))
+
+	var got []string
+	for d := range rule.Occurrences(start) {
+		got = append(got, d.Time().Format("2006-01-02 15:04 MST"))
+	}
+	expected := []string{"2026-03-23 09:00 CET", "2026-03-30 09:00 CEST"}
+	if !slices.Equal(got, expected) {
+		t.Errorf("Occurrences() = %v, want %v", got, expected)
+	}
+}
+
+func TestRRuleOccurrencesPreservesLang(t *testing.T) {
+	rule := MustParseRRule("FREQ=DAILY;COUNT=2")
+	for d := range rule.Occurrences(MustParse("2026-01-01").WithLang(DE)) {
+		if d.lang != DE {
+			t.Errorf("Occurrences() yielded lang %v, want %v", d.lang, DE)
+		}
+	}
+}
+
+func TestRRuleOccurrencesMonthEndPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     string
+		start    string
+		policy   MonthEndPolicy
+		expected []string
+	}{
+		{"monthly clamp", "FREQ=MONTHLY;COUNT=4", "2026-02-28", MonthEndClamp,
+			[]string{"2026-02-28", "2026-03-28", "2026-04-28", "2026-05-28"}},
+		{"monthly sticky", "FREQ=MONTHLY;COUNT=4", "2026-02-28", MonthEndSticky,
+			[]string{"2026-02-28", "2026-03-31", "2026-04-30", "2026-05-31"}},
+		{"monthly overflow", "FREQ=MONTHLY;COUNT=4", "2026-01-31", MonthEndOverflow,
+			[]string{"2026-01-31", "2026-03-03", "2026-03-31", "2026-05-01"}},
+		{"yearly sticky", "FREQ=YEARLY;COUNT=3", "2023-02-28", MonthEndSticky,
+			[]string{"2023-02-28", "2024-02-29", "2025-02-28"}},
+		{"yearly by month sticky", "FREQ=YEARLY;BYMONTH=2,4;COUNT=4", "2026-02-28", MonthEndSticky,
+			[]string{"2026-02-28", "2026-04-30", "2027-02-28", "2027-04-30"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := MustParseRRule(tt.rule)
+			var got []string
+			for d := range rule.Occurrences(MustParse(tt.start).WithMonthEndPolicy(tt.policy)) {
+				got = append(got, d.Format(ISO))
+			}
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("Occurrences() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRRuleOccurrencesUnbounded(t *testing.T) {
+	rule := MustParseRRule("FREQ=DAILY")
+
+	count := 0
+	for range rule.Occurrences(MustParse("2026-01-01")) {
+		count++
+		if count == 1000 {
+			break
+		}
+	}
+	if count != 1000 {
+		t.Errorf("iterations = %d, want 1000", count)
+	}
+}
+
+func TestParseRRule(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"FREQ=DAILY", "FREQ=DAILY"},
+		{"RRULE:FREQ=MONTHLY;BYDAY=2TU", "FREQ=MONTHLY;BYDAY=2TU"},
+		{"rrule:freq=monthly;byday=2tu", "FREQ=MONTHLY;BYDAY=2TU"},
+		{"FREQ=WEEKLY;INTERVAL=1;WKST=MO", "FREQ=WEEKLY"},
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,SU;WKST=SU", "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,SU;WKST=SU"},
+		{"BYMONTH=7,1;FREQ=YEARLY", "FREQ=YEARLY;BYMONTH=1,7"},
+		{"FREQ=MONTHLY;BYMONTHDAY=1,-1;COUNT=10", "FREQ=MONTHLY;COUNT=10;BYMONTHDAY=1,-1"},
+		{"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1"},
+		{"FREQ=DAILY;UNTIL=20261231", "FREQ=DAILY;UNTIL=20261231"},
+		{"FREQ=DAILY;UNTIL=20261231T235959Z", "FREQ=DAILY;UNTIL=20261231T235959Z"},
+		{"FREQ=DAILY;UNTIL=20261231T235959", "FREQ=DAILY;UNTIL=20261231T235959"},
+		{"FREQ=YEARLY;BYDAY=-53FR", "FREQ=YEARLY;BYDAY=-53FR"},
+		{"  FREQ=DAILY  ", "FREQ=DAILY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			rule, err := ParseRRule(tt.input)
+			if err != nil {
+				t.Fatalf("ParseRRule(%q) error = %v", tt.input, err)
+			}
+			if got := rule.String(); got != tt.expected {
+				t.Errorf("ParseRRule(%q).String() = %q, want %q", tt.input, got, tt.expected)
+			}
+
+			// String output must parse to the same rule
+			again, err := ParseRRule(rule.String())
+			if err != nil || again.String() != tt.expected {
+				t.Errorf("ParseRRule(%q) round trip = %q, %v", rule.String(), again.String(), err)
+			}
+		})
+	}
+}
+
+func TestParseRRuleErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"empty", ""},
+		{"prefix only", "RRULE:"},
+		{"missing FREQ", "COUNT=3"},
+		{"unknown FREQ", "FREQ=FORTNIGHTLY"},
+		{"sub-daily FREQ", "FREQ=HOURLY"},
+		{"missing value", "FREQ=DAILY;COUNT="},
+		{"missing equals sign", "FREQ=DAILY;COUNT"},
+		{"duplicate part", "FREQ=DAILY;FREQ=WEEKLY"},
+		{"unknown part", "FREQ=DAILY;FOO=1"},
+		{"unsupported part", "FREQ=DAILY;BYHOUR=9"},
+		{"zero interval", "FREQ=DAILY;INTERVAL=0"},
+		{"negative count", "FREQ=DAILY;COUNT=-1"},
+		{"COUNT and UNTIL", "FREQ=DAILY;COUNT=3;UNTIL=20261231"},
+		{"invalid UNTIL", "FREQ=DAILY;UNTIL=2026-12-31"},
+		{"month out of range", "FREQ=YEARLY;BYMONTH=13"},
+		{"negative month", "FREQ=YEARLY;BYMONTH=-1"},
+		{"month day zero", "FREQ=MONTHLY;BYMONTHDAY=0"},
+		{"month day out of range", "FREQ=MONTHLY;BYMONTHDAY=32"},
+		{"invalid weekday", "FREQ=WEEKLY;BYDAY=XX"},
+		{"weekday ordinal zero", "FREQ=MONTHLY;BYDAY=0MO"},
+		{"weekday ordinal out of range", "FREQ=MONTHLY;BYDAY=6MO"},
+		{"weekday ordinal with WEEKLY", "FREQ=WEEKLY;BYDAY=1MO"},
+		{"BYMONTHDAY with WEEKLY", "FREQ=WEEKLY;BYMONTHDAY=1"},
+		{"BYSETPOS alone", "FREQ=MONTHLY;BYSETPOS=1"},
+		{"invalid WKST", "FREQ=WEEKLY;WKST=MONDAY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseRRule(tt.input)
+			if !errors.Is(err, ErrInvalidFormat) {
+				t.Errorf("ParseRRule(%q) error = %v, want ErrInvalidFormat", tt.input, err)
+			}
+		})
+	}
+}
+
+func TestMustParseRRulePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseRRule() did not panic on invalid input")
+		}
+	}()
+	MustParseRRule("FREQ=NEVER")
+}
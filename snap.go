@@ -6,39 +6,73 @@ import "time"
 // Time is set to 00:00:00.000 unless otherwise specified.
 //
 // Supported units:
-//   - Week: Returns Monday 00:00:00 (ISO 8601 convention)
+//   - Nanosecond: Returns the date unchanged
+//   - Millisecond, Microsecond, Second, Minute: Truncates the smaller components
+//   - Hour: Returns the start of the hour on the elapsed timeline, so the two
+//     occurrences of an hour repeated by a DST fall-back stay distinct
+//   - Day: Returns midnight. If midnight does not exist because of a DST
+//     transition at 00:00, returns the first instant of the day instead.
+//   - Week: Returns the first day of the week, 00:00:00. Weeks start on
+//     Monday (ISO 8601) unless configured otherwise with WithWeekStart.
 //   - Month: Returns 1st day of month, 00:00:00
 //   - Quarter: Returns first day of quarter (Q1=Jan 1, Q2=Apr 1, Q3=Jul 1, Q4=Oct 1)
+//   - Half-year: Returns Jan 1 (H1) or Jul 1 (H2), 00:00:00
 //   - Year: Returns Jan 1, 00:00:00
+//   - Decade: Returns Jan 1 of the year divisible by 10 (e.g. 2020), 00:00:00
+//   - Century: Returns Jan 1 of the year divisible by 100 (e.g. 2000), 00:00:00
+//
+// Unknown Unit values return the zero Date (see IsZero) rather than the
+// unchanged date, so that mistakes do not go unnoticed.
 //
 // Example:
 //
 //	date := quando.From(time.Date(2026, 2, 9, 15, 30, 45, 0, time.UTC))
+//	day := date.StartOf(quando.Days)        // Feb 9, 2026 00:00:00
 //	monday := date.StartOf(quando.Week)     // Feb 9, 2026 00:00:00 (Monday)
 //	month := date.StartOf(quando.Month)     // Feb 1, 2026 00:00:00
 //	quarter := date.StartOf(quando.Quarter) // Jan 1, 2026 00:00:00 (Q1)
 //	year := date.StartOf(quando.Year)       // Jan 1, 2026 00:00:00
+//
+//	us := date.WithWeekStart(time.Sunday).StartOf(quando.Week) // Feb 8, 2026 00:00:00 (Sunday)
 func (d Date) StartOf(unit Unit) Date {
 	t := d.t
 	loc := t.Location()
 
 	switch unit {
+	case Nanoseconds:
+		return d
+
+	case Microseconds:
+		return d.with(t.Add(-time.Duration(t.Nanosecond() % 1e3)))
+
+	case Milliseconds:
+		return d.with(t.Add(-time.Duration(t.Nanosecond() % 1e6)))
+
+	case Seconds:
+		return d.with(t.Add(-time.Duration(t.Nanosecond())))
+
+	case Minutes:
+		return d.with(t.Add(-time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond())))
+
+	case Hours:
+		// Remove the wall clock remainder on the elapsed timeline, so a
+		// repeated hour during a DST fall-back keeps its own start
+		return d.with(t.Add(-time.Duration(t.Minute())*time.Minute -
+			time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond())))
+
+	case Days:
+		return d.with(startOfDay(t))
+
 	case Weeks:
-		// Find Monday of current week (ISO 8601: Monday is day 1)
-		// time.Weekday: Sunday=0, Monday=1, ..., Saturday=6
-		weekday := int(t.Weekday())
-		if weekday == 0 { // Sunday
-			weekday = 7 // Treat Sunday as day 7 for ISO 8601
-		}
-		daysToMonday := weekday - 1
-		mondayDate := t.AddDate(0, 0, -daysToMonday)
-		result := time.Date(mondayDate.Year(), mondayDate.Month(), mondayDate.Day(), 0, 0, 0, 0, loc)
-		return Date{t: result, lang: d.lang}
+		// Go back to the configured first day of the week (Monday by default)
+		firstDate := t.AddDate(0, 0, -d.daysIntoWeek())
+		result := time.Date(firstDate.Year(), firstDate.Month(), firstDate.Day(), 0, 0, 0, 0, loc)
+		return d.with(result)
 
 	case Months:
 		// First day of month, 00:00:00
 		result := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
-		return Date{t: result, lang: d.lang}
+		return d.with(result)
 
 	case Quarters:
 		// Q1=Jan-Mar (start: Jan 1), Q2=Apr-Jun (start: Apr 1),
@@ -56,51 +90,97 @@ func (d Date) StartOf(unit Unit) Date {
 			quarterStart = time.October
 		}
 		result := time.Date(t.Year(), quarterStart, 1, 0, 0, 0, 0, loc)
-		return Date{t: result, lang: d.lang}
+		return d.with(result)
+
+	case HalfYears:
+		// H1 starts Jan 1, H2 starts Jul 1
+		result := time.Date(t.Year(), (t.Month()-1)/6*6+1, 1, 0, 0, 0, 0, loc)
+		return d.with(result)
 
 	case Years:
 		// Jan 1, 00:00:00
 		result := time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, loc)
-		return Date{t: result, lang: d.lang}
+		return d.with(result)
+
+	case Decades:
+		result := time.Date(floorYear(t.Year(), 10), time.January, 1, 0, 0, 0, 0, loc)
+		return d.with(result)
+
+	case Centuries:
+		result := time.Date(floorYear(t.Year(), 100), time.January, 1, 0, 0, 0, 0, loc)
+		return d.with(result)
 
 	default:
-		// For other units, return the date unchanged
-		return d
+		// Unknown unit
+		return Date{}
 	}
 }
 
 // EndOf returns a new Date snapped to the end of the specified unit.
-// Time is set to 23:59:59.999999999.
+// Time is set to 23:59:59.999999999 unless otherwise specified.
 //
 // Supported units:
-//   - Week: Returns Sunday 23:59:59 (ISO 8601 convention)
+//   - Nanosecond: Returns the date unchanged
+//   - Millisecond, Microsecond, Second, Minute, Hour: Returns the last
+//     nanosecond of the unit, i.e. one nanosecond before the next one starts
+//   - Day: Returns the last nanosecond before the next day starts, which is
+//     23:59:59.999999999 except on days with a DST transition at midnight
+//   - Week: Returns the last day of the week, 23:59:59. Weeks end on
+//     Sunday (ISO 8601) unless configured otherwise with WithWeekStart.
 //   - Month: Returns last day of month, 23:59:59 (handles all month lengths)
 //   - Quarter: Returns last day of quarter, 23:59:59
+//   - Half-year: Returns Jun 30 (H1) or Dec 31 (H2), 23:59:59
 //   - Year: Returns Dec 31, 23:59:59
+//   - Decade: Returns Dec 31 of the decade's last year (e.g. 2029), 23:59:59
+//   - Century: Returns Dec 31 of the century's last year (e.g. 2099), 23:59:59
+//
+// Unknown Unit values return the zero Date (see IsZero), as for StartOf.
 //
 // Example:
 //
 //	date := quando.From(time.Date(2026, 2, 9, 15, 30, 45, 0, time.UTC))
+//	hourEnd := date.EndOf(quando.Hours)     // Feb 9, 2026 15:59:59
 //	sunday := date.EndOf(quando.Week)       // Feb 15, 2026 23:59:59 (Sunday)
 //	monthEnd := date.EndOf(quando.Month)    // Feb 28, 2026 23:59:59
 //	quarterEnd := date.EndOf(quando.Quarter) // Mar 31, 2026 23:59:59 (Q1)
 //	yearEnd := date.EndOf(quando.Year)      // Dec 31, 2026 23:59:59
+//
+//	us := date.WithWeekStart(time.Sunday).EndOf(quando.Week) // Feb 14, 2026 23:59:59 (Saturday)
 func (d Date) EndOf(unit Unit) Date {
 	t := d.t
 	loc := t.Location()
 
 	switch unit {
+	case Nanoseconds:
+		return d
+
+	case Microseconds:
+		return d.with(d.StartOf(Microseconds).t.Add(time.Microsecond - time.Nanosecond))
+
+	case Milliseconds:
+		return d.with(d.StartOf(Milliseconds).t.Add(time.Millisecond - time.Nanosecond))
+
+	case Seconds:
+		return d.with(d.StartOf(Seconds).t.Add(time.Second - time.Nanosecond))
+
+	case Minutes:
+		return d.with(d.StartOf(Minutes).t.Add(time.Minute - time.Nanosecond))
+
+	case Hours:
+		return d.with(d.StartOf(Hours).t.Add(time.Hour - time.Nanosecond))
+
+	case Days:
+		// The day ends just before the next one starts, which is not
+		// necessarily 24 hours after its start
+		year, month, day := t.Date()
+		next := time.Date(year, month, day+1, 12, 0, 0, 0, loc)
+		return d.with(startOfDay(next).Add(-time.Nanosecond))
+
 	case Weeks:
-		// Find Sunday of current week (ISO 8601: Sunday is day 7)
-		// time.Weekday: Sunday=0, Monday=1, ..., Saturday=6
-		weekday := int(t.Weekday())
-		if weekday == 0 { // Sunday
-			weekday = 7
-		}
-		daysToSunday := 7 - weekday
-		sundayDate := t.AddDate(0, 0, daysToSunday)
-		result := time.Date(sundayDate.Year(), sundayDate.Month(), sundayDate.Day(), 23, 59, 59, 999999999, loc)
-		return Date{t: result, lang: d.lang}
+		// Go forward to the last day of the week (Sunday by default)
+		lastDate := t.AddDate(0, 0, 6-d.daysIntoWeek())
+		result := time.Date(lastDate.Year(), lastDate.Month(), lastDate.Day(), 23, 59, 59, 999999999, loc)
+		return d.with(result)
 
 	case Months:
 		// Last day of month, 23:59:59
@@ -108,7 +188,7 @@ func (d Date) EndOf(unit Unit) Date {
 		firstOfNextMonth := time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
 		lastOfMonth := firstOfNextMonth.AddDate(0, 0, -1)
 		result := time.Date(lastOfMonth.Year(), lastOfMonth.Month(), lastOfMonth.Day(), 23, 59, 59, 999999999, loc)
-		return Date{t: result, lang: d.lang}
+		return d.with(result)
 
 	case Quarters:
 		// Q1=Jan-Mar (end: Mar 31), Q2=Apr-Jun (end: Jun 30),
@@ -129,16 +209,29 @@ func (d Date) EndOf(unit Unit) Date {
 		firstOfNextMonth := time.Date(t.Year(), quarterEnd+1, 1, 0, 0, 0, 0, loc)
 		lastOfQuarter := firstOfNextMonth.AddDate(0, 0, -1)
 		result := time.Date(lastOfQuarter.Year(), lastOfQuarter.Month(), lastOfQuarter.Day(), 23, 59, 59, 999999999, loc)
-		return Date{t: result, lang: d.lang}
+		return d.with(result)
+
+	case HalfYears:
+		// H1 ends Jun 30, H2 ends Dec 31 (day 0 of the next month)
+		result := time.Date(t.Year(), (t.Month()-1)/6*6+7, 0, 23, 59, 59, 999999999, loc)
+		return d.with(result)
 
 	case Years:
 		// Dec 31, 23:59:59
 		result := time.Date(t.Year(), time.December, 31, 23, 59, 59, 999999999, loc)
-		return Date{t: result, lang: d.lang}
+		return d.with(result)
+
+	case Decades:
+		result := time.Date(floorYear(t.Year(), 10)+9, time.December, 31, 23, 59, 59, 999999999, loc)
+		return d.with(result)
+
+	case Centuries:
+		result := time.Date(floorYear(t.Year(), 100)+99, time.December, 31, 23, 59, 59, 999999999, loc)
+		return d.with(result)
 
 	default:
-		// For other units, return the date unchanged
-		return d
+		// Unknown unit
+		return Date{}
 	}
 }
 
@@ -166,7 +259,7 @@ func (d Date) Next(weekday time.Weekday) Date {
 	}
 
 	result := t.AddDate(0, 0, daysUntil)
-	return Date{t: result, lang: d.lang}
+	return d.with(result)
 }
 
 // Prev returns a new Date representing the previous occurrence of the specified weekday.
@@ -193,5 +286,190 @@ func (d Date) Prev(weekday time.Weekday) Date {
 	}
 
 	result := t.AddDate(0, 0, -daysUntil)
-	return Date{t: result, lang: d.lang}
+	return d.with(result)
+}
+
+// NextOrSame returns the date itself if it falls on the specified weekday,
+// and otherwise the next occurrence of the weekday, like Next. The time of
+// day is preserved.
+//
+// Example:
+//
+//	friday := quando.MustParse("2026-02-13 15:30:00")
+//	friday.NextOrSame(time.Friday) // Feb 13, 2026 15:30 (same day)
+//	friday.Next(time.Friday)       // Feb 20, 2026 15:30
+func (d Date) NextOrSame(weekday time.Weekday) Date {
+	if d.t.Weekday() == weekday {
+		return d
+	}
+	return d.Next(weekday)
+}
+
+// PrevOrSame returns the date itself if it falls on the specified weekday,
+// and otherwise the previous occurrence of the weekday, like Prev. The time
+// of day is preserved.
+//
+// Example:
+//
+//	monday := quando.MustParse("2026-02-09 15:30:00")
+//	monday.PrevOrSame(time.Monday) // Feb 9, 2026 15:30 (same day)
+//	monday.PrevOrSame(time.Friday) // Feb 6, 2026 15:30
+func (d Date) PrevOrSame(weekday time.Weekday) Date {
+	if d.t.Weekday() == weekday {
+		return d
+	}
+	return d.Prev(weekday)
+}
+
+// Closest returns the occurrence of the specified weekday nearest to the
+// date: the date itself if it falls on the weekday, and otherwise whichever
+// of the next and previous occurrence is fewer days away. The time of day is
+// preserved.
+//
+// Ties cannot occur: the distances to the next and the previous occurrence
+// always add up to seven days, so one of them is at most three days away.
+//
+// Example:
+//
+//	tuesday := quando.MustParse("2026-02-10")
+//	tuesday.Closest(time.Monday)   // Feb 9, 2026 (1 day back)
+//	tuesday.Closest(time.Friday)   // Feb 13, 2026 (3 days ahead)
+//	tuesday.Closest(time.Saturday) // Feb 7, 2026 (3 days back)
+func (d Date) Closest(weekday time.Weekday) Date {
+	ahead := (int(weekday) - int(d.t.Weekday()) + 7) % 7
+	if ahead <= 3 {
+		return d.with(d.t.AddDate(0, 0, ahead))
+	}
+	return d.with(d.t.AddDate(0, 0, ahead-7))
+}
+
+// NextN returns the nth next occurrence of the specified weekday, never the
+// date itself: NextN(1, weekday) equals Next(weekday), and each further n
+// adds a week. The time of day is preserved.
+//
+// Negative values move backwards like PrevN, and zero returns the date
+// unchanged.
+//
+// Example:
+//
+//	// On Monday, Feb 9, 2026
+//	date := quando.MustParse("2026-02-09 15:30:00")
+//	date.NextN(3, time.Monday) // Mar 2, 2026 15:30 (the 3rd Monday from now)
+//	date.NextN(1, time.Friday) // Feb 13, 2026 15:30
+func (d Date) NextN(n int, weekday time.Weekday) Date {
+	switch {
+	case n < 0:
+		return d.PrevN(-n, weekday)
+	case n == 0:
+		return d
+	}
+	next := d.Next(weekday)
+	return next.with(next.t.AddDate(0, 0, 7*(n-1)))
+}
+
+// PrevN returns the nth previous occurrence of the specified weekday, never
+// the date itself: PrevN(1, weekday) equals Prev(weekday), and each further n
+// goes back another week. The time of day is preserved.
+//
+// Negative values move forwards like NextN, and zero returns the date
+// unchanged.
+//
+// Example:
+//
+//	// On Monday, Feb 9, 2026
+//	date := quando.MustParse("2026-02-09 15:30:00")
+//	date.PrevN(2, time.Friday) // Jan 30, 2026 15:30
+func (d Date) PrevN(n int, weekday time.Weekday) Date {
+	switch {
+	case n < 0:
+		return d.NextN(-n, weekday)
+	case n == 0:
+		return d
+	}
+	prev := d.Prev(weekday)
+	return prev.with(prev.t.AddDate(0, 0, -7*(n-1)))
+}
+
+// NthWeekdayOf returns the nth occurrence of the weekday within the month,
+// quarter, half-year, year, decade or century containing the date. The time
+// of day is preserved from the source date, as with Next and Prev.
+//
+// Positive n counts from the start of the period (1 = first), negative n
+// counts from the end (-1 = last). The second result is false if the period
+// has no such occurrence (e.g. a fifth Monday in a month with four), if n is
+// zero, or if unit is shorter than Months; the Date is then the zero Date.
+//
+// Example:
+//
+//	date := quando.MustParse("2026-11-10")
+//	expiry, _ := date.NthWeekdayOf(3, time.Friday, quando.Months)          // Nov 20, 2026
+//	thanksgiving, _ := date.NthWeekdayOf(-1, time.Thursday, quando.Months) // Nov 26, 2026
+//	first, _ := date.NthWeekdayOf(1, time.Monday, quando.Quarters)         // Oct 5, 2026
+//	_, ok := date.NthWeekdayOf(5, time.Friday, quando.Months)              // false
+func (d Date) NthWeekdayOf(n int, weekday time.Weekday, unit Unit) (Date, bool) {
+	switch unit {
+	case Months, Quarters, HalfYears, Years, Decades, Centuries:
+	default:
+		return Date{}, false
+	}
+	if n == 0 {
+		return Date{}, false
+	}
+
+	// Count on calendar days at noon, clear of DST transitions
+	loc := d.t.Location()
+	first, last := d.StartOf(unit).t, d.EndOf(unit).t
+	firstDay := time.Date(first.Year(), first.Month(), first.Day(), 12, 0, 0, 0, loc)
+	lastDay := time.Date(last.Year(), last.Month(), last.Day(), 12, 0, 0, 0, loc)
+
+	day, ok := nthWeekdayBetween(firstDay, lastDay, weekday, n)
+	if !ok {
+		return Date{}, false
+	}
+
+	hour, minute, second := d.t.Clock()
+	result := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, d.t.Nanosecond(), loc)
+	return d.with(result), true
+}
+
+// nthWeekdayBetween returns the nth occurrence of weekday among the days from
+// first to last (inclusive), at the time of day of first and last. Positive n
+// counts from first (1 = first occurrence), negative n counts from last
+// (-1 = last occurrence). The day is computed even if it lies outside the
+// range; ok reports whether it is inside and n is not zero.
+func nthWeekdayBetween(first, last time.Time, weekday time.Weekday, n int) (day time.Time, ok bool) {
+	switch {
+	case n > 0:
+		offset := (int(weekday) - int(first.Weekday()) + 7) % 7
+		day = first.AddDate(0, 0, offset+7*(n-1))
+		return day, !day.After(last)
+	case n < 0:
+		offset := (int(last.Weekday()) - int(weekday) + 7) % 7
+		day = last.AddDate(0, 0, -offset-7*(-n-1))
+		return day, !day.Before(first)
+	default:
+		return first, false
+	}
+}
+
+// floorYear rounds year down to a multiple of n, also for years before 1 AD.
+func floorYear(year, n int) int {
+	if year < 0 && year%n != 0 {
+		return year - year%n - n
+	}
+	return year - year%n
+}
+
+// startOfDay returns the first instant of the calendar day of t in t's
+// location. This is midnight, unless a DST transition at 00:00 skips
+// midnight; the day then starts at the transition.
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	start := time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	if start.Day() != day {
+		// Normalized into the previous day: the day starts when the
+		// previous zone period ends
+		_, start = start.ZoneBounds()
+	}
+	return start
 }
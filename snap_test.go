@@ -731,12 +731,11 @@ func TestNextPrevTimezones(t *testing.T) {
 func TestStartOf_UnsupportedUnit(t *testing.T) {
 	date := From(time.Date(2026, 2, 15, 14, 30, 45, 0, time.UTC))
 
-	// Seconds is not supported by StartOf
-	result := date.StartOf(Seconds)
+	// Unknown units return the zero Date
+	result := date.StartOf(Unit(99))
 
-	// Should return unchanged date
-	if !result.Time().Equal(date.Time()) {
-		t.Errorf("StartOf(Seconds) should return unchanged date")
+	if !result.IsZero() {
+		t.Errorf("StartOf(Unit(99)) = %v, want zero Date", result)
 	}
 }
 
@@ -744,12 +743,365 @@ func TestStartOf_UnsupportedUnit(t *testing.T) {
 func TestEndOf_UnsupportedUnit(t *testing.T) {
 	date := From(time.Date(2026, 2, 15, 14, 30, 45, 0, time.UTC))
 
-	// Seconds is not supported by EndOf
-	result := date.EndOf(Seconds)
+	// Unknown units return the zero Date
+	result := date.EndOf(Unit(99))
 
-	// Should return unchanged date
-	if !result.Time().Equal(date.Time()) {
-		t.Errorf("EndOf(Seconds) should return unchanged date")
+	if !result.IsZero() {
+		t.Errorf("EndOf(Unit(99)) = %v, want zero Date", result)
 	}
 }
 
+
+func TestStartEndOfSubWeekUnits(t *testing.T) {
+	date := From(time.Date(2026, 2, 9, 15, 30, 45, 123456789, time.UTC))
+
+	tests := []struct {
+		unit  Unit
+		start time.Time
+		end   time.Time
+	}{
+		{Seconds, time.Date(2026, 2, 9, 15, 30, 45, 0, time.UTC), time.Date(2026, 2, 9, 15, 30, 45, 999999999, time.UTC)},
+		{Minutes, time.Date(2026, 2, 9, 15, 30, 0, 0, time.UTC), time.Date(2026, 2, 9, 15, 30, 59, 999999999, time.UTC)},
+		{Hours, time.Date(2026, 2, 9, 15, 0, 0, 0, time.UTC), time.Date(2026, 2, 9, 15, 59, 59, 999999999, time.UTC)},
+		{Days, time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC), time.Date(2026, 2, 9, 23, 59, 59, 999999999, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.unit.String(), func(t *testing.T) {
+			if got := date.StartOf(tt.unit).Time(); !got.Equal(tt.start) {
+				t.Errorf("StartOf(%v) = %v, want %v", tt.unit, got, tt.start)
+			}
+			if got := date.EndOf(tt.unit).Time(); !got.Equal(tt.end) {
+				t.Errorf("EndOf(%v) = %v, want %v", tt.unit, got, tt.end)
+			}
+		})
+	}
+}
+
+func TestStartEndOfDaysDST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data not available: %v", err)
+	}
+
+	// Spring forward: March 29, 2026 has 23 hours
+	spring := From(time.Date(2026, 3, 29, 12, 0, 0, 0, berlin))
+	start, end := spring.StartOf(Days).Time(), spring.EndOf(Days).Time()
+	if want := time.Date(2026, 3, 29, 0, 0, 0, 0, berlin); !start.Equal(want) {
+		t.Errorf("StartOf(Days) = %v, want %v", start, want)
+	}
+	if got := end.Sub(start); got != 23*time.Hour-time.Nanosecond {
+		t.Errorf("day length = %v, want 23h", got+time.Nanosecond)
+	}
+
+	// Fall back: October 25, 2026 has 25 hours
+	fall := From(time.Date(2026, 10, 25, 12, 0, 0, 0, berlin))
+	start, end = fall.StartOf(Days).Time(), fall.EndOf(Days).Time()
+	if got := end.Sub(start); got != 25*time.Hour-time.Nanosecond {
+		t.Errorf("day length = %v, want 25h", got+time.Nanosecond)
+	}
+}
+
+func TestStartOfDaysMissingMidnight(t *testing.T) {
+	// In 2018, Brazil moved clocks from 00:00 to 01:00 on November 4
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Skipf("timezone data not available: %v", err)
+	}
+
+	date := From(time.Date(2018, 11, 4, 12, 0, 0, 0, saoPaulo))
+	start := date.StartOf(Days).Time()
+	if got := start.Format("2006-01-02 15:04:05 -07:00"); got != "2018-11-04 01:00:00 -02:00" {
+		t.Errorf("StartOf(Days) = %s, want 2018-11-04 01:00:00 -02:00", got)
+	}
+
+	// The previous day ends just before the transition
+	end := From(time.Date(2018, 11, 3, 12, 0, 0, 0, saoPaulo)).EndOf(Days).Time()
+	if !end.Add(time.Nanosecond).Equal(start) {
+		t.Errorf("EndOf(Days) of previous day = %v, want 1ns before %v", end, start)
+	}
+	if end.Day() != 3 {
+		t.Errorf("EndOf(Days) of previous day = %v, want November 3", end)
+	}
+}
+
+func TestStartEndOfHoursRepeatedHour(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data not available: %v", err)
+	}
+
+	// 02:30 occurs twice on October 25, 2026: at 00:30 and 01:30 UTC
+	first := From(time.Date(2026, 10, 25, 0, 30, 0, 0, time.UTC).In(berlin))
+	second := From(time.Date(2026, 10, 25, 1, 30, 0, 0, time.UTC).In(berlin))
+
+	if got, want := first.StartOf(Hours).Time(), time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("first StartOf(Hours) = %v, want %v", got, want)
+	}
+	if got, want := second.StartOf(Hours).Time(), time.Date(2026, 10, 25, 1, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("second StartOf(Hours) = %v, want %v", got, want)
+	}
+	if got, want := first.EndOf(Hours).Time(), time.Date(2026, 10, 25, 0, 59, 59, 999999999, time.UTC); !got.Equal(want) {
+		t.Errorf("first EndOf(Hours) = %v, want %v", got, want)
+	}
+}
+
+func TestSnapPreservesSettings(t *testing.T) {
+	date := From(time.Date(2026, 2, 9, 15, 30, 45, 0, time.UTC)).WithLang(DE).WithWeekStart(time.Sunday)
+	for _, unit := range []Unit{Seconds, Minutes, Hours, Days} {
+		if got := date.StartOf(unit); got.lang != DE || got.WeekStart() != time.Sunday {
+			t.Errorf("StartOf(%v) lost settings", unit)
+		}
+		if got := date.EndOf(unit); got.lang != DE || got.WeekStart() != time.Sunday {
+			t.Errorf("EndOf(%v) lost settings", unit)
+		}
+	}
+}
+
+func TestNthWeekdayOf(t *testing.T) {
+	date := From(time.Date(2026, 11, 10, 9, 30, 0, 0, time.UTC))
+
+	tests := []struct {
+		name    string
+		n       int
+		weekday time.Weekday
+		unit    Unit
+		want    string
+		wantOK  bool
+	}{
+		{"third Friday of month", 3, time.Friday, Months, "2026-11-20 09:30:00", true},
+		{"first Sunday is the 1st", 1, time.Sunday, Months, "2026-11-01 09:30:00", true},
+		{"fifth Monday exists", 5, time.Monday, Months, "2026-11-30 09:30:00", true},
+		{"fifth Friday does not exist", 5, time.Friday, Months, "", false},
+		{"last Thursday of month", -1, time.Thursday, Months, "2026-11-26 09:30:00", true},
+		{"last Monday is the 30th", -1, time.Monday, Months, "2026-11-30 09:30:00", true},
+		{"second to last Friday", -2, time.Friday, Months, "2026-11-20 09:30:00", true},
+		{"fifth from last Friday does not exist", -5, time.Friday, Months, "", false},
+		{"first Monday of quarter", 1, time.Monday, Quarters, "2026-10-05 09:30:00", true},
+		{"last Friday of quarter", -1, time.Friday, Quarters, "2026-12-25 09:30:00", true},
+		{"14th Thursday of quarter", 14, time.Thursday, Quarters, "2026-12-31 09:30:00", true},
+		{"14th Friday of quarter does not exist", 14, time.Friday, Quarters, "", false},
+		{"first Monday of year", 1, time.Monday, Years, "2026-01-05 09:30:00", true},
+		{"53rd Thursday of year", 53, time.Thursday, Years, "2026-12-31 09:30:00", true},
+		{"53rd Friday of year does not exist", 53, time.Friday, Years, "", false},
+		{"last Sunday of year", -1, time.Sunday, Years, "2026-12-27 09:30:00", true},
+		{"zero n", 0, time.Friday, Months, "", false},
+		{"unsupported unit", 1, time.Friday, Weeks, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := date.NthWeekdayOf(tt.n, tt.weekday, tt.unit)
+			if ok != tt.wantOK {
+				t.Fatalf("NthWeekdayOf() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				if !got.IsZero() {
+					t.Errorf("NthWeekdayOf() = %v, want zero Date", got)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("NthWeekdayOf() = %v, want %v", got, tt.want)
+			}
+			if got.Time().Weekday() != tt.weekday {
+				t.Errorf("NthWeekdayOf() weekday = %v, want %v", got.Time().Weekday(), tt.weekday)
+			}
+		})
+	}
+}
+
+// TestNthWeekdayOfMatchesHolidayRules verifies that NthWeekdayOf and the
+// nth-weekday rule of the holiday calendars agree.
+func TestNthWeekdayOfMatchesHolidayRules(t *testing.T) {
+	for month := time.January; month <= time.December; month++ {
+		date := From(time.Date(2026, month, 15, 0, 0, 0, 0, time.UTC))
+		for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+			for _, n := range []int{1, 2, 3, 4, -1, -2} {
+				got, ok := date.NthWeekdayOf(n, weekday, Months)
+				want := nthWeekdayOfMonth(2026, month, weekday, n, time.UTC)
+				if !ok || !got.Time().Equal(want) {
+					t.Errorf("NthWeekdayOf(%d, %v) in %v = %v, %v, want %v",
+						n, weekday, month, got.Format(ISO), ok, want.Format("2006-01-02"))
+				}
+			}
+		}
+	}
+}
+
+func TestNthWeekdayOfDST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data not available: %v", err)
+	}
+
+	// Last Sunday of March 2026 is the day clocks spring forward
+	date := From(time.Date(2026, 3, 2, 10, 0, 0, 0, berlin))
+	got, ok := date.NthWeekdayOf(-1, time.Sunday, Months)
+	want := time.Date(2026, 3, 29, 10, 0, 0, 0, berlin)
+	if !ok || !got.Time().Equal(want) {
+		t.Errorf("NthWeekdayOf(-1, Sunday, Months) = %v, %v, want %v", got.Time(), ok, want)
+	}
+}
+
+func TestNextOrSamePrevOrSame(t *testing.T) {
+	monday := From(time.Date(2026, 2, 9, 15, 30, 0, 0, time.UTC))
+
+	tests := []struct {
+		name string
+		got  Date
+		want string
+	}{
+		{"NextOrSame same day", monday.NextOrSame(time.Monday), "2026-02-09 15:30:00"},
+		{"NextOrSame later this week", monday.NextOrSame(time.Friday), "2026-02-13 15:30:00"},
+		{"NextOrSame Sunday", monday.NextOrSame(time.Sunday), "2026-02-15 15:30:00"},
+		{"PrevOrSame same day", monday.PrevOrSame(time.Monday), "2026-02-09 15:30:00"},
+		{"PrevOrSame last week", monday.PrevOrSame(time.Friday), "2026-02-06 15:30:00"},
+		{"PrevOrSame Tuesday", monday.PrevOrSame(time.Tuesday), "2026-02-03 15:30:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.String() != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClosest(t *testing.T) {
+	tuesday := From(time.Date(2026, 2, 10, 8, 15, 0, 0, time.UTC))
+
+	tests := []struct {
+		weekday time.Weekday
+		want    string
+	}{
+		{time.Tuesday, "2026-02-10 08:15:00"},   // same day
+		{time.Monday, "2026-02-09 08:15:00"},    // 1 back
+		{time.Wednesday, "2026-02-11 08:15:00"}, // 1 ahead
+		{time.Thursday, "2026-02-12 08:15:00"},  // 2 ahead
+		{time.Friday, "2026-02-13 08:15:00"},    // 3 ahead, 4 back
+		{time.Saturday, "2026-02-07 08:15:00"},  // 4 ahead, 3 back
+		{time.Sunday, "2026-02-08 08:15:00"},    // 5 ahead, 2 back
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.weekday.String(), func(t *testing.T) {
+			got := tuesday.Closest(tt.weekday)
+			if got.String() != tt.want {
+				t.Errorf("Closest(%v) = %v, want %v", tt.weekday, got, tt.want)
+			}
+			if diff := got.Time().Sub(tuesday.Time()); diff < -72*time.Hour || diff > 72*time.Hour {
+				t.Errorf("Closest(%v) is %v away, want at most 3 days", tt.weekday, diff)
+			}
+		})
+	}
+}
+
+func TestNextNPrevN(t *testing.T) {
+	monday := From(time.Date(2026, 2, 9, 15, 30, 0, 0, time.UTC))
+
+	tests := []struct {
+		name string
+		got  Date
+		want string
+	}{
+		{"NextN 1 equals Next", monday.NextN(1, time.Monday), monday.Next(time.Monday).String()},
+		{"NextN 3rd Monday", monday.NextN(3, time.Monday), "2026-03-02 15:30:00"},
+		{"NextN 2nd Friday", monday.NextN(2, time.Friday), "2026-02-20 15:30:00"},
+		{"NextN zero", monday.NextN(0, time.Friday), "2026-02-09 15:30:00"},
+		{"NextN negative", monday.NextN(-2, time.Friday), "2026-01-30 15:30:00"},
+		{"PrevN 1 equals Prev", monday.PrevN(1, time.Monday), monday.Prev(time.Monday).String()},
+		{"PrevN 2nd Friday", monday.PrevN(2, time.Friday), "2026-01-30 15:30:00"},
+		{"PrevN 4th Monday", monday.PrevN(4, time.Monday), "2026-01-12 15:30:00"},
+		{"PrevN zero", monday.PrevN(0, time.Friday), "2026-02-09 15:30:00"},
+		{"PrevN negative", monday.PrevN(-3, time.Monday), "2026-03-02 15:30:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.String() != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextNDST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data not available: %v", err)
+	}
+
+	// Crosses the spring-forward transition on March 29, 2026
+	date := From(time.Date(2026, 3, 20, 9, 0, 0, 0, berlin)) // Friday
+	got := date.NextN(2, time.Friday).Time()
+	if want := time.Date(2026, 4, 3, 9, 0, 0, 0, berlin); !got.Equal(want) {
+		t.Errorf("NextN(2, Friday) = %v, want %v", got, want)
+	}
+	if got := date.Closest(time.Monday).Time(); !got.Equal(time.Date(2026, 3, 23, 9, 0, 0, 0, berlin)) {
+		t.Errorf("Closest(Monday) = %v, want 2026-03-23 09:00 CET", got)
+	}
+}
+
+func TestStartEndOfExtendedUnits(t *testing.T) {
+	date := From(time.Date(2026, 8, 9, 15, 30, 45, 123456789, time.UTC))
+
+	tests := []struct {
+		unit  Unit
+		start time.Time
+		end   time.Time
+	}{
+		{Nanoseconds, date.Time(), date.Time()},
+		{Microseconds, time.Date(2026, 8, 9, 15, 30, 45, 123456000, time.UTC), time.Date(2026, 8, 9, 15, 30, 45, 123456999, time.UTC)},
+		{Milliseconds, time.Date(2026, 8, 9, 15, 30, 45, 123000000, time.UTC), time.Date(2026, 8, 9, 15, 30, 45, 123999999, time.UTC)},
+		{HalfYears, time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Decades, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2029, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Centuries, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2099, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.unit.String(), func(t *testing.T) {
+			if got := date.StartOf(tt.unit).Time(); !got.Equal(tt.start) {
+				t.Errorf("StartOf(%v) = %v, want %v", tt.unit, got, tt.start)
+			}
+			if got := date.EndOf(tt.unit).Time(); !got.Equal(tt.end) {
+				t.Errorf("EndOf(%v) = %v, want %v", tt.unit, got, tt.end)
+			}
+		})
+	}
+}
+
+func TestStartEndOfHalfYears(t *testing.T) {
+	for month := time.January; month <= time.December; month++ {
+		date := From(time.Date(2026, month, 15, 12, 0, 0, 0, time.UTC))
+		wantStart, wantEnd := "2026-01-01 00:00:00", "2026-06-30 23:59:59"
+		if month >= time.July {
+			wantStart, wantEnd = "2026-07-01 00:00:00", "2026-12-31 23:59:59"
+		}
+		if got := date.StartOf(HalfYears).String(); got != wantStart {
+			t.Errorf("%s: StartOf(HalfYears) = %s, want %s", month, got, wantStart)
+		}
+		if got := date.EndOf(HalfYears).String(); got != wantEnd {
+			t.Errorf("%s: EndOf(HalfYears) = %s, want %s", month, got, wantEnd)
+		}
+	}
+}
+
+func TestFloorYear(t *testing.T) {
+	tests := []struct {
+		year, n, want int
+	}{
+		{2026, 10, 2020},
+		{2020, 10, 2020},
+		{2099, 100, 2000},
+		{1, 100, 0},
+		{-5, 10, -10},
+		{-10, 10, -10},
+	}
+	for _, tt := range tests {
+		if got := floorYear(tt.year, tt.n); got != tt.want {
+			t.Errorf("floorYear(%d, %d) = %d, want %d", tt.year, tt.n, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package quando
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Scan implements sql.Scanner so a Date can be used directly as a scan
+// destination in database/sql queries.
+//
+// Supported source types:
+//   - time.Time: used as-is (the usual case for DATE/TIMESTAMP columns)
+//   - []byte, string: parsed with Parse (e.g. SQLite TEXT columns)
+//
+// The resulting Date uses EN as its language. NULL values cannot be scanned
+// into a Date; use NullDate for nullable columns.
+//
+// Returns an error wrapping ErrInvalidFormat for NULL, unsupported source
+// types, or strings that cannot be parsed.
+//
+// Example:
+//
+//	var due quando.Date
+//	err := db.QueryRow("SELECT due FROM invoices WHERE id = $1", id).Scan(&due)
+func (d *Date) Scan(src any) error {
+	switch v := src.(type) {
+	case time.Time:
+		*d = From(v)
+		return nil
+	case []byte:
+		return d.scanString(string(v))
+	case string:
+		return d.scanString(v)
+	case nil:
+		return fmt.Errorf("scanning date: NULL value (use NullDate for nullable columns): %w", ErrInvalidFormat)
+	default:
+		return fmt.Errorf("scanning date: unsupported source type %T: %w", src, ErrInvalidFormat)
+	}
+}
+
+// scanString parses a textual database value into d.
+func (d *Date) scanString(s string) error {
+	parsed, err := Parse(s)
+	if err != nil {
+		return fmt.Errorf("scanning date: %w", err)
+	}
+	*d = parsed
+	return nil
+}
+
+// Value implements driver.Valuer so a Date can be passed directly as a query argument.
+// The date is handed to the driver as a time.Time.
+//
+// Example:
+//
+//	_, err := db.Exec("UPDATE invoices SET due = $1 WHERE id = $2", due, id)
+func (d Date) Value() (driver.Value, error) {
+	return d.t, nil
+}
+
+// NullDate represents a Date that may be NULL. It mirrors sql.NullTime and
+// implements sql.Scanner and driver.Valuer.
+//
+// Example:
+//
+//	var paid quando.NullDate
+//	err := db.QueryRow("SELECT paid_at FROM invoices WHERE id = $1", id).Scan(&paid)
+//	if paid.Valid {
+//	    fmt.Println(paid.Date)
+//	}
+type NullDate struct {
+	Date  Date
+	Valid bool // Valid is true if Date is not NULL
+}
+
+// Scan implements sql.Scanner. A NULL value sets Valid to false.
+// All other values are scanned with Date.Scan.
+func (n *NullDate) Scan(src any) error {
+	if src == nil {
+		n.Date, n.Valid = Date{}, false
+		return nil
+	}
+
+	if err := n.Date.Scan(src); err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements driver.Valuer. It returns nil (NULL) if Valid is false.
+func (n NullDate) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Date.Value()
+}
+
+// Scan implements sql.Scanner so a Duration can be read back from a text
+// column written by Duration.Value.
+//
+// The source must be an ISO 8601 time interval of the form "start/end"
+// (e.g. "2026-01-01T00:00:00Z/2026-02-01T00:00:00Z"), given as []byte or
+// string. Both instants are parsed with Parse. The weekend is not stored and
+// resets to the default (Saturday and Sunday).
+//
+// Returns an error wrapping ErrInvalidFormat for NULL, unsupported source
+// types, or strings that are not a valid interval.
+//
+// Example:
+//
+//	var span quando.Duration
+//	err := db.QueryRow("SELECT span FROM contracts WHERE id = $1", id).Scan(&span)
+func (d *Duration) Scan(src any) error {
+	var s string
+	switch v := src.(type) {
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	case nil:
+		return fmt.Errorf("scanning duration: NULL value: %w", ErrInvalidFormat)
+	default:
+		return fmt.Errorf("scanning duration: unsupported source type %T: %w", src, ErrInvalidFormat)
+	}
+
+	startText, endText, ok := strings.Cut(s, "/")
+	if !ok {
+		return fmt.Errorf("scanning duration %q: expected \"start/end\": %w", s, ErrInvalidFormat)
+	}
+	start, err := Parse(startText)
+	if err != nil {
+		return fmt.Errorf("scanning duration: start: %w", err)
+	}
+	end, err := Parse(endText)
+	if err != nil {
+		return fmt.Errorf("scanning duration: end: %w", err)
+	}
+	*d = Diff(start.t, end.t)
+	return nil
+}
+
+// Value implements driver.Valuer so a Duration can be passed directly as a
+// query argument.
+//
+// A Duration is a span between two instants rather than a fixed length, so it
+// is not mapped to an SQL INTERVAL (whose support also differs between
+// databases). Instead it is handed to the driver as an ISO 8601 time interval
+// string, "start/end" in RFC 3339 with nanosecond precision, which keeps both
+// instants and their offsets and fits any text column. The weekend setting is
+// not stored.
+//
+// Example:
+//
+//	span := quando.Diff(signed, expires)
+//	_, err := db.Exec("UPDATE contracts SET span = $1 WHERE id = $2", span, id)
+func (d Duration) Value() (driver.Value, error) {
+	return d.start.Format(time.RFC3339Nano) + "/" + d.end.Format(time.RFC3339Nano), nil
+}
@@ -0,0 +1,255 @@
+package quando
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+)
+
+// Compile-time interface checks
+var (
+	_ sql.Scanner   = (*Date)(nil)
+	_ driver.Valuer = Date{}
+	_ sql.Scanner   = (*NullDate)(nil)
+	_ driver.Valuer = NullDate{}
+	_ sql.Scanner   = (*Duration)(nil)
+	_ driver.Valuer = Duration{}
+)
+
+func TestDateScan(t *testing.T) {
+	expected := time.Date(2026, 2, 9, 12, 30, 45, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		src  any
+	}{
+		{"time.Time", expected},
+		{"string RFC3339", "2026-02-09T12:30:45Z"},
+		{"string SQL datetime", "2026-02-09 12:30:45"},
+		{"bytes SQL datetime with offset", []byte("2026-02-09 13:30:45+01:00")},
+		{"bytes PostgreSQL format", []byte("2026-02-09 13:30:45+01")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Date
+			if err := d.Scan(tt.src); err != nil {
+				t.Fatalf("Scan(%v) unexpected error: %v", tt.src, err)
+			}
+			if !d.Time().Equal(expected) {
+				t.Errorf("Scan(%v) = %v, want %v", tt.src, d.Time(), expected)
+			}
+			if d.lang != EN {
+				t.Errorf("Scan(%v) lang = %v, want %v", tt.src, d.lang, EN)
+			}
+		})
+	}
+}
+
+func TestDateScanDateOnly(t *testing.T) {
+	var d Date
+	if err := d.Scan("2026-02-09"); err != nil {
+		t.Fatalf("Scan() unexpected error: %v", err)
+	}
+	if d.String() != "2026-02-09 00:00:00" {
+		t.Errorf("Scan() = %v, want 2026-02-09 00:00:00", d)
+	}
+}
+
+func TestDateScanErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  any
+	}{
+		{"NULL", nil},
+		{"int64", int64(1707480000)},
+		{"float64", 1.5},
+		{"invalid string", "not-a-date"},
+		{"invalid bytes", []byte("01/02/2026")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Date
+			err := d.Scan(tt.src)
+			if !errors.Is(err, ErrInvalidFormat) {
+				t.Errorf("Scan(%v) error = %v, want ErrInvalidFormat", tt.src, err)
+			}
+		})
+	}
+}
+
+func TestDateValue(t *testing.T) {
+	tm := time.Date(2026, 2, 9, 12, 30, 45, 0, time.UTC)
+	v, err := From(tm).WithLang(DE).Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+
+	got, ok := v.(time.Time)
+	if !ok {
+		t.Fatalf("Value() type = %T, want time.Time", v)
+	}
+	if !got.Equal(tm) {
+		t.Errorf("Value() = %v, want %v", got, tm)
+	}
+
+	// The value must be accepted by the default driver converter
+	if !driver.IsValue(v) {
+		t.Errorf("Value() = %v is not a valid driver.Value", v)
+	}
+}
+
+func TestNullDateScan(t *testing.T) {
+	t.Run("NULL", func(t *testing.T) {
+		n := NullDate{Date: Now(), Valid: true}
+		if err := n.Scan(nil); err != nil {
+			t.Fatalf("Scan(nil) unexpected error: %v", err)
+		}
+		if n.Valid {
+			t.Error("Scan(nil) Valid = true, want false")
+		}
+		if n.Date != (Date{}) {
+			t.Errorf("Scan(nil) Date = %v, want zero Date", n.Date)
+		}
+	})
+
+	t.Run("value", func(t *testing.T) {
+		var n NullDate
+		if err := n.Scan("2026-02-09"); err != nil {
+			t.Fatalf("Scan() unexpected error: %v", err)
+		}
+		if !n.Valid {
+			t.Error("Scan() Valid = false, want true")
+		}
+		if n.Date.Format(ISO) != "2026-02-09" {
+			t.Errorf("Scan() Date = %v, want 2026-02-09", n.Date)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		n := NullDate{Valid: true}
+		err := n.Scan(42)
+		if !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("Scan(42) error = %v, want ErrInvalidFormat", err)
+		}
+		if n.Valid {
+			t.Error("Scan(42) Valid = true, want false")
+		}
+	})
+}
+
+func TestNullDateValue(t *testing.T) {
+	v, err := NullDate{}.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if v != nil {
+		t.Errorf("Value() for invalid NullDate = %v, want nil", v)
+	}
+
+	tm := time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC)
+	v, err = NullDate{Date: From(tm), Valid: true}.Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	if got, ok := v.(time.Time); !ok || !got.Equal(tm) {
+		t.Errorf("Value() = %v, want %v", v, tm)
+	}
+}
+
+func TestDurationValueScanRoundTrip(t *testing.T) {
+	berlin := time.FixedZone("CET", 3600)
+	tests := []struct {
+		name       string
+		start, end time.Time
+	}{
+		{"positive", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"negative", time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC), time.Date(2025, 12, 31, 8, 30, 0, 0, time.UTC)},
+		{"fractional seconds with offset", time.Date(2026, 2, 9, 12, 30, 45, 123456789, berlin), time.Date(2026, 2, 10, 0, 0, 0, 1, berlin)},
+		{"empty", time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC), time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := Diff(tt.start, tt.end).Value()
+			if err != nil {
+				t.Fatalf("Value() unexpected error: %v", err)
+			}
+			if !driver.IsValue(v) {
+				t.Fatalf("Value() = %v is not a valid driver.Value", v)
+			}
+
+			var got Duration
+			if err := got.Scan(v); err != nil {
+				t.Fatalf("Scan(%v) unexpected error: %v", v, err)
+			}
+			if !got.start.Equal(tt.start) || !got.end.Equal(tt.end) {
+				t.Errorf("Scan(%v) = %v/%v, want %v/%v", v, got.start, got.end, tt.start, tt.end)
+			}
+			_, gotOffset := got.start.Zone()
+			_, wantOffset := tt.start.Zone()
+			if gotOffset != wantOffset {
+				t.Errorf("Scan(%v) start offset = %d, want %d", v, gotOffset, wantOffset)
+			}
+		})
+	}
+}
+
+func TestDurationValue(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 2, 1, 12, 0, 0, 500, time.UTC)
+	v, err := Diff(start, end).Value()
+	if err != nil {
+		t.Fatalf("Value() unexpected error: %v", err)
+	}
+	want := "2026-01-01T00:00:00Z/2026-02-01T12:00:00.0000005Z"
+	if v != want {
+		t.Errorf("Value() = %v, want %v", v, want)
+	}
+}
+
+func TestDurationScan(t *testing.T) {
+	var d Duration
+	if err := d.Scan([]byte("2026-01-01/2026-01-15")); err != nil {
+		t.Fatalf("Scan() unexpected error: %v", err)
+	}
+	if d.Days() != 14 {
+		t.Errorf("Scan() Days() = %d, want 14", d.Days())
+	}
+
+	d = d.WithWeekend(FridaySaturday)
+	if err := d.Scan("2026-02-12T00:00:00Z/2026-02-14T00:00:00Z"); err != nil {
+		t.Fatalf("Scan() unexpected error: %v", err)
+	}
+	if d.weekend != 0 {
+		t.Errorf("Scan() weekend = %v, want default", d.weekend)
+	}
+}
+
+func TestDurationScanErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  any
+	}{
+		{"NULL", nil},
+		{"time.Time", time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC)},
+		{"int64", int64(86400)},
+		{"missing separator", "2026-01-01T00:00:00Z"},
+		{"invalid start", "soon/2026-01-01T00:00:00Z"},
+		{"invalid end", []byte("2026-01-01T00:00:00Z/later")},
+		{"ISO 8601 duration", "P1M"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Duration
+			err := d.Scan(tt.src)
+			if !errors.Is(err, ErrInvalidFormat) {
+				t.Errorf("Scan(%v) error = %v, want ErrInvalidFormat", tt.src, err)
+			}
+		})
+	}
+}
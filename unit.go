@@ -4,12 +4,14 @@ package quando
 // and for snap operations like StartOf and EndOf.
 //
 // Use the predefined constants (Seconds, Minutes, Hours, Days, Weeks, Months,
-// Quarters, Years) rather than creating Unit values directly.
+// Quarters, Years, HalfYears, Decades, Centuries, Milliseconds, Microseconds,
+// Nanoseconds) rather than creating Unit values directly.
 type Unit int
 
 // Time unit constants for use with arithmetic and snap operations.
-// These constants are ordered from smallest to largest unit, except for Quarters
-// which is a special alias for 3 months.
+// Seconds through Years are ordered from smallest to largest unit, except for
+// Quarters which is a special alias for 3 months. The units added later are
+// appended after Years so that existing values stay stable.
 const (
 	// Seconds represents the seconds time unit.
 	Seconds Unit = iota
@@ -29,6 +31,21 @@ const (
 	Quarters
 	// Years represents the years time unit.
 	Years
+	// HalfYears represents the half-years time unit (6 months).
+	// H1 is January to June, H2 is July to December.
+	HalfYears
+	// Decades represents the decades time unit (10 years).
+	// Decades are aligned to years divisible by 10 (e.g. 2020-2029).
+	Decades
+	// Centuries represents the centuries time unit (100 years).
+	// Centuries are aligned to years divisible by 100 (e.g. 2000-2099).
+	Centuries
+	// Milliseconds represents the milliseconds time unit.
+	Milliseconds
+	// Microseconds represents the microseconds time unit.
+	Microseconds
+	// Nanoseconds represents the nanoseconds time unit.
+	Nanoseconds
 )
 
 // String returns the string representation of the Unit.
@@ -51,6 +68,18 @@ func (u Unit) String() string {
 		return "quarters"
 	case Years:
 		return "years"
+	case HalfYears:
+		return "half-years"
+	case Decades:
+		return "decades"
+	case Centuries:
+		return "centuries"
+	case Milliseconds:
+		return "milliseconds"
+	case Microseconds:
+		return "microseconds"
+	case Nanoseconds:
+		return "nanoseconds"
 	default:
 		return "unknown"
 	}
@@ -20,6 +20,12 @@ func TestUnitConstants(t *testing.T) {
 		{"Months", Months, 5, "months"},
 		{"Quarters", Quarters, 6, "quarters"},
 		{"Years", Years, 7, "years"},
+		{"HalfYears", HalfYears, 8, "half-years"},
+		{"Decades", Decades, 9, "decades"},
+		{"Centuries", Centuries, 10, "centuries"},
+		{"Milliseconds", Milliseconds, 11, "milliseconds"},
+		{"Microseconds", Microseconds, 12, "microseconds"},
+		{"Nanoseconds", Nanoseconds, 13, "nanoseconds"},
 	}
 
 	for _, tt := range tests {
@@ -76,6 +82,12 @@ func TestUnitString(t *testing.T) {
 		{"Months", Months, "months"},
 		{"Quarters", Quarters, "quarters"},
 		{"Years", Years, "years"},
+		{"HalfYears", HalfYears, "half-years"},
+		{"Decades", Decades, "decades"},
+		{"Centuries", Centuries, "centuries"},
+		{"Milliseconds", Milliseconds, "milliseconds"},
+		{"Microseconds", Microseconds, "microseconds"},
+		{"Nanoseconds", Nanoseconds, "nanoseconds"},
 		{"Unknown", Unit(999), "unknown"},
 		{"Negative", Unit(-1), "unknown"},
 	}
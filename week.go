@@ -0,0 +1,101 @@
+package quando
+
+import (
+	"strings"
+	"time"
+)
+
+// WithWeekStart returns a new Date whose weeks start on the given weekday.
+//
+// The week start is used by StartOf(Weeks), EndOf(Weeks) and WeekOfYear.
+// The default is Monday, following ISO 8601. The setting is carried over to
+// all dates derived from the result (e.g. by Add or StartOf). Values outside
+// time.Sunday to time.Saturday are wrapped into range.
+//
+// Example:
+//
+//	date := quando.From(time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC)) // Wednesday
+//	date.WithWeekStart(time.Sunday).StartOf(quando.Weeks)   // Feb 8, 2026 (Sunday)
+//	date.WithWeekStart(time.Saturday).StartOf(quando.Weeks) // Feb 7, 2026 (Saturday)
+func (d Date) WithWeekStart(day time.Weekday) Date {
+	d.weekStart = int8((weekdayBit(day) + 6) % 7)
+	return d
+}
+
+// WeekStart returns the weekday on which weeks start for this date.
+// Monday is the default (ISO 8601).
+//
+// Example:
+//
+//	quando.Now().WeekStart()                            // time.Monday
+//	quando.Now().WithWeekStart(time.Sunday).WeekStart() // time.Sunday
+func (d Date) WeekStart() time.Weekday {
+	return time.Weekday((int(d.weekStart) + 1) % 7)
+}
+
+// daysIntoWeek returns the number of days between the start of the week and
+// the date (0-6).
+func (d Date) daysIntoWeek() int {
+	return (int(d.t.Weekday()) - int(d.WeekStart()) + 7) % 7
+}
+
+// sundayWeekStart and saturdayWeekStart list the regions whose weeks start
+// on Sunday or Saturday, following the CLDR week data. All other regions
+// start weeks on Monday.
+var (
+	sundayWeekStart   = regionSet("AG AS BD BR BS BT BW BZ CA CN CO DM DO ET GT GU HK HN ID IL IN JM JP KE KH KR LA MH MM MO MT MX MZ NI NP PA PE PH PK PR PT PY SA SG SV TH TT TW UM US VE VI WS YE ZA ZW")
+	saturdayWeekStart = regionSet("AE AF BH DJ DZ EG IQ IR JO KW LY OM QA SD SY")
+)
+
+// regionSet builds a lookup set from a space-separated list of region codes.
+func regionSet(codes string) map[string]bool {
+	set := make(map[string]bool)
+	for code := range strings.FieldsSeq(codes) {
+		set[code] = true
+	}
+	return set
+}
+
+// WeekStartFor returns the first day of the week customary in the given
+// locale, following the CLDR week data.
+//
+// The locale can be an ISO 3166 region code ("US") or a language tag with a
+// region subtag ("en-US", "en_US", "ar-EG"). Locales without a region and
+// unknown regions default to Monday (ISO 8601).
+//
+// Example:
+//
+//	quando.WeekStartFor("en-US") // time.Sunday
+//	quando.WeekStartFor("de-DE") // time.Monday
+//	quando.WeekStartFor("EG")    // time.Saturday
+//
+//	date := quando.Now().WithWeekStart(quando.WeekStartFor("en-US"))
+func WeekStartFor(locale string) time.Weekday {
+	region := localeRegion(locale)
+	switch {
+	case sundayWeekStart[region]:
+		return time.Sunday
+	case saturdayWeekStart[region]:
+		return time.Saturday
+	default:
+		return time.Monday
+	}
+}
+
+// localeRegion extracts the upper-cased region code from a region code or a
+// language tag. It returns "" if the locale has no region.
+func localeRegion(locale string) string {
+	parts := strings.FieldsFunc(locale, func(r rune) bool { return r == '-' || r == '_' })
+	if len(parts) == 1 && len(parts[0]) == 2 && strings.ToUpper(parts[0]) == parts[0] {
+		// Bare region code, e.g. "US" (lowercase "en" is a language)
+		return parts[0]
+	}
+	// The region is the first two-letter subtag after the language,
+	// e.g. "en-US" or "zh-Hant-TW"
+	for _, part := range parts[min(1, len(parts)):] {
+		if len(part) == 2 {
+			return strings.ToUpper(part)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,163 @@
+package quando
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWithWeekStart(t *testing.T) {
+	date := From(time.Date(2026, 2, 11, 15, 30, 45, 0, time.UTC)) // Wednesday
+
+	tests := []struct {
+		weekStart time.Weekday
+		wantStart string
+		wantEnd   string
+	}{
+		{time.Monday, "2026-02-09 00:00:00", "2026-02-15 23:59:59"},
+		{time.Tuesday, "2026-02-10 00:00:00", "2026-02-16 23:59:59"},
+		{time.Wednesday, "2026-02-11 00:00:00", "2026-02-17 23:59:59"},
+		{time.Thursday, "2026-02-05 00:00:00", "2026-02-11 23:59:59"},
+		{time.Friday, "2026-02-06 00:00:00", "2026-02-12 23:59:59"},
+		{time.Saturday, "2026-02-07 00:00:00", "2026-02-13 23:59:59"},
+		{time.Sunday, "2026-02-08 00:00:00", "2026-02-14 23:59:59"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.weekStart.String(), func(t *testing.T) {
+			d := date.WithWeekStart(tt.weekStart)
+			if got := d.WeekStart(); got != tt.weekStart {
+				t.Errorf("WeekStart() = %v, want %v", got, tt.weekStart)
+			}
+
+			start := d.StartOf(Weeks)
+			if start.String() != tt.wantStart {
+				t.Errorf("StartOf(Weeks) = %v, want %v", start, tt.wantStart)
+			}
+			if start.Time().Weekday() != tt.weekStart {
+				t.Errorf("StartOf(Weeks) weekday = %v, want %v", start.Time().Weekday(), tt.weekStart)
+			}
+
+			end := d.EndOf(Weeks)
+			if end.String() != tt.wantEnd {
+				t.Errorf("EndOf(Weeks) = %v, want %v", end, tt.wantEnd)
+			}
+			if end.Time().Sub(start.Time()) != 7*24*time.Hour-time.Nanosecond {
+				t.Errorf("week spans %v, want 7 days", end.Time().Sub(start.Time()))
+			}
+		})
+	}
+}
+
+func TestWeekStartDefault(t *testing.T) {
+	if got := Now().WeekStart(); got != time.Monday {
+		t.Errorf("Now().WeekStart() = %v, want Monday", got)
+	}
+	var zero Date
+	if got := zero.WeekStart(); got != time.Monday {
+		t.Errorf("Date{}.WeekStart() = %v, want Monday", got)
+	}
+}
+
+func TestWithWeekStartOutOfRange(t *testing.T) {
+	date := From(time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC)) // Wednesday
+
+	tests := []struct {
+		day  time.Weekday
+		want time.Weekday
+	}{
+		{time.Weekday(7), time.Sunday},
+		{time.Weekday(15), time.Monday},
+		{time.Weekday(-1), time.Saturday},
+		{time.Weekday(-7), time.Sunday},
+		{time.Weekday(-8), time.Saturday},
+		{time.Weekday(-13), time.Monday},
+	}
+
+	for _, tt := range tests {
+		d := date.WithWeekStart(tt.day)
+		if got := d.WeekStart(); got != tt.want {
+			t.Errorf("WithWeekStart(%d).WeekStart() = %v, want %v", int(tt.day), got, tt.want)
+		}
+		if got := d.StartOf(Weeks).Time().Weekday(); got != tt.want {
+			t.Errorf("WithWeekStart(%d).StartOf(Weeks) weekday = %v, want %v", int(tt.day), got, tt.want)
+		}
+	}
+}
+
+func TestWeekStartPreserved(t *testing.T) {
+	date := From(time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC)).WithWeekStart(time.Sunday)
+
+	derived := []struct {
+		name string
+		date Date
+	}{
+		{"Add", date.Add(1, Months)},
+		{"Sub", date.Sub(3, Days)},
+		{"StartOf", date.StartOf(Months)},
+		{"EndOf", date.EndOf(Years)},
+		{"Next", date.Next(time.Friday)},
+		{"WithLang", date.WithLang(DE)},
+	}
+	for _, tt := range derived {
+		if got := tt.date.WeekStart(); got != time.Sunday {
+			t.Errorf("%s: WeekStart() = %v, want Sunday", tt.name, got)
+		}
+	}
+
+	berlin, err := date.In("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data not available: %v", err)
+	}
+	if got := berlin.WeekStart(); got != time.Sunday {
+		t.Errorf("In: WeekStart() = %v, want Sunday", got)
+	}
+}
+
+func TestWeekStartDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("timezone data not available: %v", err)
+	}
+
+	// DST starts on Sunday, March 8, 2026; the week still starts at midnight
+	date := From(time.Date(2026, 3, 11, 12, 0, 0, 0, loc)).WithWeekStart(time.Sunday)
+	start := date.StartOf(Weeks).Time()
+	want := time.Date(2026, 3, 8, 0, 0, 0, 0, loc)
+	if !start.Equal(want) {
+		t.Errorf("StartOf(Weeks) = %v, want %v", start, want)
+	}
+}
+
+func TestWeekStartFor(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   time.Weekday
+	}{
+		{"US", time.Sunday},
+		{"en-US", time.Sunday},
+		{"en_US", time.Sunday},
+		{"en-us", time.Sunday},
+		{"pt-BR", time.Sunday},
+		{"ja-JP", time.Sunday},
+		{"zh-Hant-TW", time.Sunday},
+		{"he-IL", time.Sunday},
+		{"ar-SA", time.Sunday},
+		{"ar-EG", time.Saturday},
+		{"fa-IR", time.Saturday},
+		{"AE", time.Saturday},
+		{"de-DE", time.Monday},
+		{"en-GB", time.Monday},
+		{"fr", time.Monday},
+		{"en", time.Monday},
+		{"", time.Monday},
+		{"XX", time.Monday},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			if got := WeekStartFor(tt.locale); got != tt.want {
+				t.Errorf("WeekStartFor(%q) = %v, want %v", tt.locale, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package quando
+
+import "time"
+
+// Weekend is the set of weekdays that are considered weekend days.
+//
+// The zero value is the ISO convention of Saturday and Sunday, so Dates
+// without an explicit weekend behave as before. Use the predefined values or
+// NewWeekend for other conventions, and attach a weekend to a Date with
+// WithWeekend.
+//
+// Example:
+//
+//	israel := quando.FridaySaturday
+//	custom := quando.NewWeekend(time.Friday)
+type Weekend uint8
+
+// noWeekendBit marks a weekend without any days, which would otherwise be
+// indistinguishable from the zero value.
+const noWeekendBit Weekend = 1 << 7
+
+// Predefined weekend definitions.
+const (
+	SaturdaySunday Weekend = 1<<time.Saturday | 1<<time.Sunday // Most of the world (ISO)
+	FridaySaturday Weekend = 1<<time.Friday | 1<<time.Saturday // Israel and most Arab states
+	ThursdayFriday Weekend = 1<<time.Thursday | 1<<time.Friday // Afghanistan
+	FridayOnly     Weekend = 1 << time.Friday                  // Iran
+	SundayOnly     Weekend = 1 << time.Sunday                  // India, Uganda
+	NoWeekend      Weekend = noWeekendBit                      // Every day is a working day
+)
+
+// NewWeekend returns a Weekend consisting of the given weekdays.
+// Without arguments, it returns NoWeekend.
+//
+// Example:
+//
+//	weekend := quando.NewWeekend(time.Friday, time.Saturday) // same as FridaySaturday
+func NewWeekend(days ...time.Weekday) Weekend {
+	w := noWeekendBit
+	for _, day := range days {
+		w |= 1 << weekdayBit(day)
+	}
+	if w != noWeekendBit {
+		w &^= noWeekendBit
+	}
+	return w
+}
+
+// Has reports whether the given weekday is a weekend day.
+//
+// Example:
+//
+//	quando.FridaySaturday.Has(time.Sunday) // false
+func (w Weekend) Has(day time.Weekday) bool {
+	if w == 0 {
+		w = SaturdaySunday
+	}
+	return w&(1<<weekdayBit(day)) != 0
+}
+
+// weekdayBit returns the bit position of day (0 = Sunday), wrapping values
+// outside 0-6 into range so that the shift is never negative.
+func weekdayBit(day time.Weekday) int {
+	return ((int(day) % 7) + 7) % 7
+}
+
+// WithWeekend returns a new Date that uses the given weekend definition.
+//
+// The weekend is used by IsWeekend and therefore by all business-day
+// methods. The setting is carried over to all dates derived from the result.
+//
+// Example:
+//
+//	date := quando.MustParse("2026-02-13") // Friday
+//	date.IsWeekend()                                    // false
+//	date.WithWeekend(quando.FridaySaturday).IsWeekend() // true
+func (d Date) WithWeekend(w Weekend) Date {
+	d.weekend = w
+	return d
+}
+
+// Weekend returns the weekend definition of the date.
+// Dates use SaturdaySunday unless configured otherwise with WithWeekend.
+func (d Date) Weekend() Weekend {
+	if d.weekend == 0 {
+		return SaturdaySunday
+	}
+	return d.weekend
+}
+
+// Weekend definitions per region that differ from Saturday and Sunday,
+// following the CLDR week data.
+var (
+	fridaySaturdayWeekend = regionSet("BH DZ EG IL IQ JO KW LY MV OM QA SA SD SY YE")
+	thursdayFridayWeekend = regionSet("AF")
+	fridayOnlyWeekend     = regionSet("IR")
+	sundayOnlyWeekend     = regionSet("IN UG")
+)
+
+// WeekendFor returns the weekend customary in the given locale, following
+// the CLDR week data.
+//
+// The locale can be an ISO 3166 region code ("IL") or a language tag with a
+// region subtag ("he-IL", "ar_SA"). Locales without a region and unknown
+// regions default to SaturdaySunday.
+//
+// Example:
+//
+//	quando.WeekendFor("he-IL") // quando.FridaySaturday
+//	quando.WeekendFor("fa-IR") // quando.FridayOnly
+//	quando.WeekendFor("en-US") // quando.SaturdaySunday
+//
+//	date := quando.Now().WithWeekend(quando.WeekendFor("ar-SA"))
+func WeekendFor(locale string) Weekend {
+	region := localeRegion(locale)
+	switch {
+	case fridaySaturdayWeekend[region]:
+		return FridaySaturday
+	case thursdayFridayWeekend[region]:
+		return ThursdayFriday
+	case fridayOnlyWeekend[region]:
+		return FridayOnly
+	case sundayOnlyWeekend[region]:
+		return SundayOnly
+	default:
+		return SaturdaySunday
+	}
+}
+
+// WithWeekend returns a copy of the duration whose business-day calculations
+// use the given weekend definition instead of Saturday and Sunday.
+//
+// Example:
+//
+//	start := time.Date(2026, 2, 12, 0, 0, 0, 0, time.UTC) // Thursday
+//	end := time.Date(2026, 2, 19, 0, 0, 0, 0, time.UTC)   // next Thursday
+//	quando.Diff(start, end).WithWeekend(quando.FridaySaturday).BusinessDays() // 5
+func (d Duration) WithWeekend(w Weekend) Duration {
+	d.weekend = w
+	return d
+}
@@ -0,0 +1,217 @@
+package quando
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeekendHas(t *testing.T) {
+	tests := []struct {
+		name    string
+		weekend Weekend
+		want    []time.Weekday // in time.Weekday order
+	}{
+		{"zero value", 0, []time.Weekday{time.Sunday, time.Saturday}},
+		{"SaturdaySunday", SaturdaySunday, []time.Weekday{time.Sunday, time.Saturday}},
+		{"FridaySaturday", FridaySaturday, []time.Weekday{time.Friday, time.Saturday}},
+		{"ThursdayFriday", ThursdayFriday, []time.Weekday{time.Thursday, time.Friday}},
+		{"FridayOnly", FridayOnly, []time.Weekday{time.Friday}},
+		{"SundayOnly", SundayOnly, []time.Weekday{time.Sunday}},
+		{"NoWeekend", NoWeekend, nil},
+		{"NewWeekend custom", NewWeekend(time.Wednesday, time.Sunday), []time.Weekday{time.Sunday, time.Wednesday}},
+		{"NewWeekend empty", NewWeekend(), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []time.Weekday
+			for day := time.Sunday; day <= time.Saturday; day++ {
+				if tt.weekend.Has(day) {
+					got = append(got, day)
+				}
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("weekend days = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("weekend days = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestNewWeekendEqualsPredefined(t *testing.T) {
+	if got := NewWeekend(time.Friday, time.Saturday); got != FridaySaturday {
+		t.Errorf("NewWeekend(Friday, Saturday) = %v, want FridaySaturday", got)
+	}
+	if got := NewWeekend(time.Sunday, time.Saturday); got != SaturdaySunday {
+		t.Errorf("NewWeekend(Sunday, Saturday) = %v, want SaturdaySunday", got)
+	}
+	if got := NewWeekend(); got != NoWeekend {
+		t.Errorf("NewWeekend() = %v, want NoWeekend", got)
+	}
+}
+
+func TestWeekendOutOfRangeWeekday(t *testing.T) {
+	// Out-of-range weekdays wrap around instead of panicking
+	if got := NewWeekend(time.Weekday(-1)); got != NewWeekend(time.Saturday) {
+		t.Errorf("NewWeekend(-1) = %v, want Saturday only", got)
+	}
+	if got := NewWeekend(time.Weekday(12)); got != NewWeekend(time.Friday) {
+		t.Errorf("NewWeekend(12) = %v, want Friday only", got)
+	}
+	if !SaturdaySunday.Has(time.Weekday(-1)) {
+		t.Error("SaturdaySunday.Has(-1) = false, want true (Saturday)")
+	}
+	if SaturdaySunday.Has(time.Weekday(-2)) {
+		t.Error("SaturdaySunday.Has(-2) = true, want false (Friday)")
+	}
+}
+
+func TestWithWeekendIsWeekend(t *testing.T) {
+	// Feb 9-15, 2026 is Monday to Sunday
+	week := make([]Date, 7)
+	for i := range week {
+		week[i] = From(time.Date(2026, 2, 9+i, 12, 0, 0, 0, time.UTC))
+	}
+
+	tests := []struct {
+		name    string
+		weekend Weekend
+		want    [7]bool // Monday..Sunday
+	}{
+		{"default", 0, [7]bool{false, false, false, false, false, true, true}},
+		{"FridaySaturday", FridaySaturday, [7]bool{false, false, false, false, true, true, false}},
+		{"FridayOnly", FridayOnly, [7]bool{false, false, false, false, true, false, false}},
+		{"NoWeekend", NoWeekend, [7]bool{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, day := range week {
+				d := day
+				if tt.weekend != 0 {
+					d = d.WithWeekend(tt.weekend)
+				}
+				if got := d.IsWeekend(); got != tt.want[i] {
+					t.Errorf("%s IsWeekend() = %v, want %v", d.Time().Weekday(), got, tt.want[i])
+				}
+				if got := d.Info().IsWeekend; got != tt.want[i] {
+					t.Errorf("%s Info().IsWeekend = %v, want %v", d.Time().Weekday(), got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDateWeekend(t *testing.T) {
+	date := MustParse("2026-02-13")
+	if got := date.Weekend(); got != SaturdaySunday {
+		t.Errorf("Weekend() = %v, want SaturdaySunday", got)
+	}
+	if got := date.WithWeekend(FridayOnly).Weekend(); got != FridayOnly {
+		t.Errorf("WithWeekend(FridayOnly).Weekend() = %v, want FridayOnly", got)
+	}
+
+	// The weekend is preserved by derived dates
+	derived := date.WithWeekend(FridaySaturday).Add(1, Months).StartOf(Weeks).WithLang(DE)
+	if got := derived.Weekend(); got != FridaySaturday {
+		t.Errorf("derived Weekend() = %v, want FridaySaturday", got)
+	}
+}
+
+func TestBusinessDaysWithWeekend(t *testing.T) {
+	// Thursday, Feb 12, 2026 in Israel: the next business day is Sunday
+	thursday := MustParse("2026-02-12").WithWeekend(FridaySaturday)
+
+	if got := thursday.NextBusinessDay().String(); got != "2026-02-15 00:00:00" {
+		t.Errorf("NextBusinessDay() = %v, want 2026-02-15 00:00:00", got)
+	}
+	if got := thursday.AddBusinessDays(5).String(); got != "2026-02-19 00:00:00" {
+		t.Errorf("AddBusinessDays(5) = %v, want 2026-02-19 00:00:00", got)
+	}
+	sunday := MustParse("2026-02-15").WithWeekend(FridaySaturday)
+	if got := sunday.PrevBusinessDay().String(); got != "2026-02-12 00:00:00" {
+		t.Errorf("PrevBusinessDay() = %v, want 2026-02-12 00:00:00", got)
+	}
+	if !sunday.IsBusinessDay() {
+		t.Error("Sunday IsBusinessDay() = false, want true with FridaySaturday weekend")
+	}
+
+	// Every day is a business day without a weekend
+	saturday := MustParse("2026-02-14").WithWeekend(NoWeekend)
+	if got := saturday.NextBusinessDay().String(); got != "2026-02-15 00:00:00" {
+		t.Errorf("NoWeekend NextBusinessDay() = %v, want 2026-02-15 00:00:00", got)
+	}
+
+	// Holidays still apply on top of the weekend
+	holidays := NewHolidayList(MustParse("2026-02-15"))
+	if got := thursday.NextBusinessDay(holidays).String(); got != "2026-02-16 00:00:00" {
+		t.Errorf("NextBusinessDay(holidays) = %v, want 2026-02-16 00:00:00", got)
+	}
+
+	// A weekend covering every day has no business days
+	if got := thursday.WithWeekend(NewWeekend(time.Sunday, time.Monday, time.Tuesday,
+		time.Wednesday, time.Thursday, time.Friday, time.Saturday)).NextBusinessDay(); !got.Time().IsZero() {
+		t.Errorf("NextBusinessDay() with all-day weekend = %v, want zero Date", got)
+	}
+}
+
+func TestDurationBusinessDaysWithWeekend(t *testing.T) {
+	start := time.Date(2026, 2, 12, 0, 0, 0, 0, time.UTC) // Thursday
+	end := time.Date(2026, 2, 19, 0, 0, 0, 0, time.UTC)   // next Thursday
+
+	tests := []struct {
+		name    string
+		weekend Weekend
+		want    int
+	}{
+		{"default", 0, 5},
+		{"FridaySaturday", FridaySaturday, 5},
+		{"FridayOnly", FridayOnly, 6},
+		{"NoWeekend", NoWeekend, 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := Diff(start, end)
+			if tt.weekend != 0 {
+				d = d.WithWeekend(tt.weekend)
+			}
+			if got := d.BusinessDays(); got != tt.want {
+				t.Errorf("BusinessDays() = %d, want %d", got, tt.want)
+			}
+			if got := Diff(end, start).WithWeekend(tt.weekend).BusinessDays(); got != -tt.want {
+				t.Errorf("reverse BusinessDays() = %d, want %d", got, -tt.want)
+			}
+		})
+	}
+}
+
+func TestWeekendFor(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   Weekend
+	}{
+		{"he-IL", FridaySaturday},
+		{"IL", FridaySaturday},
+		{"ar_SA", FridaySaturday},
+		{"ar-QA", FridaySaturday},
+		{"fa-IR", FridayOnly},
+		{"ps-AF", ThursdayFriday},
+		{"hi-IN", SundayOnly},
+		{"en-US", SaturdaySunday},
+		{"de", SaturdaySunday},
+		{"", SaturdaySunday},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			if got := WeekendFor(tt.locale); got != tt.want {
+				t.Errorf("WeekendFor(%q) = %v, want %v", tt.locale, got, tt.want)
+			}
+		})
+	}
+}